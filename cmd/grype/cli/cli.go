@@ -81,6 +81,9 @@ func create(id clio.Identification) (clio.Application, *cobra.Command) {
 		commands.DB(app),
 		commands.Completion(app),
 		commands.Explain(app),
+		commands.Diff(app),
+		commands.Ignore(app),
+		commands.Maven(app),
 		clio.VersionCommand(id, syftVersion, dbVersion),
 		clio.ConfigCommand(app, nil),
 	)
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/diff"
+	"github.com/anchore/grype/internal/bus"
+)
+
+type diffOptions struct {
+	Output string `yaml:"output" json:"output" mapstructure:"output"`
+}
+
+var _ clio.FlagAdder = (*diffOptions)(nil)
+
+func (d *diffOptions) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&d.Output, "output", "o", "format to display results (available=[table, json])")
+}
+
+func Diff(app clio.Application) *cobra.Command {
+	opts := &diffOptions{
+		Output: tableOutputFormat,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "diff [flags] base_report target_report",
+		Short: "Compare the findings of two grype JSON reports",
+		Long: `Compare the findings of two grype JSON reports (from "grype -o json"), showing the added, removed and
+changed findings (severity changes, newly available fixes and package version bumps).`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: disableUI(app),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runDiff(opts, args[0], args[1])
+		},
+	}
+
+	// prevent from being shown in the grype config
+	type configWrapper struct {
+		Opts *diffOptions `json:"-" yaml:"-" mapstructure:"-"`
+	}
+
+	return app.SetupCommand(cmd, &configWrapper{opts})
+}
+
+func runDiff(opts *diffOptions, base, target string) error {
+	baseDoc, err := diff.ReadDocument(base)
+	if err != nil {
+		return err
+	}
+
+	targetDoc, err := diff.ReadDocument(target)
+	if err != nil {
+		return err
+	}
+
+	sb := &strings.Builder{}
+	if err := diff.Present(opts.Output, diff.Compare(*baseDoc, *targetDoc), sb); err != nil {
+		return err
+	}
+
+	bus.Report(sb.String())
+
+	return nil
+}
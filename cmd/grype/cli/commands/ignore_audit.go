@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/internal/bus"
+)
+
+const (
+	ignoreRuleExpired  = "expired"
+	ignoreRuleExpiring = "expiring"
+)
+
+type ignoreAuditOptions struct {
+	Output string `yaml:"output" json:"output" mapstructure:"output"`
+	Within int    `yaml:"within" json:"within" mapstructure:"within"`
+}
+
+var _ clio.FlagAdder = (*ignoreAuditOptions)(nil)
+
+func (o *ignoreAuditOptions) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&o.Output, "output", "o", "format to display results (available=[table, json])")
+	flags.IntVarP(&o.Within, "within", "", "number of days within which a rule is considered to be expiring soon")
+}
+
+func IgnoreAudit(app clio.Application) *cobra.Command {
+	opts := &ignoreAuditOptions{
+		Output: tableOutputFormat,
+		Within: 30,
+	}
+
+	// the ignore rules are read from the same location in the config as when scanning
+	type configWrapper struct {
+		Hidden *ignoreAuditOptions `json:"-" yaml:"-" mapstructure:"-"`
+		Ignore []match.IgnoreRule  `yaml:"ignore" json:"ignore" mapstructure:"ignore"`
+	}
+	cfg := &configWrapper{Hidden: opts}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List ignore rules from the configuration that have expired or will expire soon",
+		Long: `List the ignore rules from the configuration that have expired (and no longer suppress any findings) or
+that will expire within the given number of days, along with the owner of each rule.`,
+		Args:    cobra.ExactArgs(0),
+		PreRunE: disableUI(app),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runIgnoreAudit(*opts, cfg.Ignore)
+		},
+	}
+
+	return app.SetupCommand(cmd, cfg)
+}
+
+type ignoreRuleAudit struct {
+	Status    string           `json:"status"`
+	ExpiresAt time.Time        `json:"expiresAt"`
+	Owner     string           `json:"owner,omitempty"`
+	Rule      match.IgnoreRule `json:"rule"`
+}
+
+func runIgnoreAudit(opts ignoreAuditOptions, rules []match.IgnoreRule) error {
+	audits, err := auditIgnoreRules(rules, time.Now(), opts.Within)
+	if err != nil {
+		return err
+	}
+
+	sb := &strings.Builder{}
+	if err := presentIgnoreAudit(opts.Output, sb, audits); err != nil {
+		return err
+	}
+
+	bus.Report(sb.String())
+
+	return nil
+}
+
+// auditIgnoreRules returns the rules that have expired as of the given time or will expire within the given number of
+// days, ordered by expiration.
+func auditIgnoreRules(rules []match.IgnoreRule, at time.Time, withinDays int) ([]ignoreRuleAudit, error) {
+	horizon := at.AddDate(0, 0, withinDays)
+
+	audits := make([]ignoreRuleAudit, 0)
+	for _, rule := range rules {
+		expiresAt, err := rule.ExpiresAt()
+		if err != nil {
+			return nil, err
+		}
+		if expiresAt.IsZero() {
+			continue
+		}
+
+		var status string
+		switch {
+		case rule.IsExpired(at):
+			status = ignoreRuleExpired
+		case expiresAt.Before(horizon):
+			status = ignoreRuleExpiring
+		default:
+			continue
+		}
+
+		audits = append(audits, ignoreRuleAudit{
+			Status:    status,
+			ExpiresAt: expiresAt,
+			Owner:     rule.Owner,
+			Rule:      rule,
+		})
+	}
+
+	sort.SliceStable(audits, func(i, j int) bool {
+		return audits[i].ExpiresAt.Before(audits[j].ExpiresAt)
+	})
+
+	return audits, nil
+}
+
+func presentIgnoreAudit(format string, writer io.Writer, audits []ignoreRuleAudit) error {
+	switch format {
+	case tableOutputFormat:
+		if len(audits) == 0 {
+			_, err := io.WriteString(writer, "No expired or expiring ignore rules found\n")
+			return err
+		}
+
+		rows := make([][]string, 0, len(audits))
+		for _, a := range audits {
+			rows = append(rows, []string{a.Status, a.Rule.Expires, a.Owner, a.Rule.Vulnerability, a.Rule.Package.Name, a.Rule.Reason})
+		}
+
+		table := tablewriter.NewWriter(writer)
+		table.SetHeader([]string{"Status", "Expires", "Owner", "Vulnerability", "Package", "Reason"})
+		table.SetAutoWrapText(false)
+		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+
+		table.SetHeaderLine(false)
+		table.SetBorder(false)
+		table.SetAutoFormatHeaders(true)
+		table.SetCenterSeparator("")
+		table.SetColumnSeparator("")
+		table.SetRowSeparator("")
+		table.SetTablePadding("  ")
+		table.SetNoWhiteSpace(true)
+
+		table.AppendBulk(rows)
+		table.Render()
+	case jsonOutputFormat:
+		enc := json.NewEncoder(writer)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", " ")
+		if err := enc.Encode(audits); err != nil {
+			return fmt.Errorf("failed to encode ignore rule audit: %+v", err)
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/match"
+)
+
+func TestAuditIgnoreRules(t *testing.T) {
+	at := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	rules := []match.IgnoreRule{
+		{Vulnerability: "CVE-2024-0001"},                                            // never expires
+		{Vulnerability: "CVE-2024-0002", Expires: "2025-12-31", Owner: "team-a"},    // not expiring soon
+		{Vulnerability: "CVE-2024-0003", Expires: "2025-07-01", Owner: "team-b"},    // expiring
+		{Vulnerability: "CVE-2024-0004", Expires: "2025-06-01", Owner: "team-c"},    // expired
+		{Vulnerability: "CVE-2024-0005", Expires: "2025-06-14T12:00:00Z"},           // expired
+		{Package: match.IgnoreRulePackage{Name: "libfoo"}, Expires: "2025-06-15"},   // expiring (end of today)
+		{Vulnerability: "CVE-2024-0006", Expires: "2025-07-16", Reason: "accepted"}, // outside of the window
+	}
+
+	audits, err := auditIgnoreRules(rules, at, 30)
+	require.NoError(t, err)
+
+	var got []string
+	for _, a := range audits {
+		got = append(got, a.Status+" "+a.Rule.Expires+" "+a.Owner)
+	}
+	assert.Equal(t, []string{
+		"expired 2025-06-01 team-c",
+		"expired 2025-06-14T12:00:00Z ",
+		"expiring 2025-06-15 ",
+		"expiring 2025-07-01 team-b",
+	}, got)
+
+	_, err = auditIgnoreRules([]match.IgnoreRule{{Expires: "someday"}}, at, 30)
+	assert.Error(t, err)
+}
+
+func TestPresentIgnoreAudit(t *testing.T) {
+	audits, err := auditIgnoreRules([]match.IgnoreRule{
+		{Vulnerability: "CVE-2024-0003", Expires: "2025-07-01", Owner: "team-b", Reason: "not exploitable"},
+		{Vulnerability: "CVE-2024-0004", Package: match.IgnoreRulePackage{Name: "libfoo"}, Expires: "2025-06-01", Owner: "team-c"},
+	}, time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC), 30)
+	require.NoError(t, err)
+
+	var table bytes.Buffer
+	require.NoError(t, presentIgnoreAudit(tableOutputFormat, &table, audits))
+	expected := "STATUS    EXPIRES     OWNER   VULNERABILITY  PACKAGE  REASON          \n" +
+		"expired   2025-06-01  team-c  CVE-2024-0004  libfoo                    \n" +
+		"expiring  2025-07-01  team-b  CVE-2024-0003           not exploitable  \n"
+	assert.Equal(t, expected, table.String())
+
+	var out bytes.Buffer
+	require.NoError(t, presentIgnoreAudit(jsonOutputFormat, &out, audits))
+	var decoded []ignoreRuleAudit
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Equal(t, audits, decoded)
+
+	var empty bytes.Buffer
+	require.NoError(t, presentIgnoreAudit(tableOutputFormat, &empty, nil))
+	assert.Equal(t, "No expired or expiring ignore rules found\n", empty.String())
+
+	assert.Error(t, presentIgnoreAudit("sarif", &empty, audits))
+}
@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/clio"
+)
+
+func Maven(app clio.Application) *cobra.Command {
+	maven := &cobra.Command{
+		Use:   "maven",
+		Short: "maven artifact lookup operations",
+	}
+
+	maven.AddCommand(
+		MavenIndex(app),
+	)
+
+	return maven
+}
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/cobra"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/db/v5/matcher/java"
+	"github.com/anchore/grype/internal/bus"
+	"github.com/anchore/grype/internal/log"
+)
+
+const defaultMavenRepository = "~/.m2/repository"
+
+type mavenIndexOptions struct {
+	File  string `yaml:"file" json:"file" mapstructure:"file"`
+	Merge bool   `yaml:"merge" json:"merge" mapstructure:"merge"`
+}
+
+var _ clio.FlagAdder = (*mavenIndexOptions)(nil)
+
+func (o *mavenIndexOptions) AddFlags(flags clio.FlagSet) {
+	flags.StringVarP(&o.File, "file", "", "file to write the index to (defaults to stdout)")
+	flags.BoolVarP(&o.Merge, "merge", "", "add to the entries already in the index file instead of replacing them")
+}
+
+func MavenIndex(app clio.Application) *cobra.Command {
+	opts := &mavenIndexOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "index [DIR...]",
+		Short: "Build a SHA1 index of the artifacts in local maven repositories",
+		Long: fmt.Sprintf(`Build a SHA1 index of the artifacts in maven repository directories (defaults to %s), for use
+with the "index" external-sources.maven.backend. Any directory with the maven repository layout can be indexed,
+such as a repository exported from Nexus or Artifactory.`, defaultMavenRepository),
+		Example: `  grype maven index --file maven-index.txt
+  grype maven index --file maven-index.txt --merge /mnt/nexus-export/maven-releases`,
+		PreRunE: disableUI(app),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runMavenIndex(*opts, args)
+		},
+	}
+
+	// prevent from being shown in the grype config
+	type configWrapper struct {
+		Hidden *mavenIndexOptions `json:"-" yaml:"-" mapstructure:"-"`
+	}
+
+	return app.SetupCommand(cmd, &configWrapper{opts})
+}
+
+func runMavenIndex(opts mavenIndexOptions, dirs []string) error {
+	if len(dirs) == 0 {
+		dirs = []string{defaultMavenRepository}
+	}
+
+	index, err := readExistingMavenIndex(opts)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		expanded, err := homedir.Expand(dir)
+		if err != nil {
+			return fmt.Errorf("unable to expand path %q: %w", dir, err)
+		}
+		count, err := index.AddRepository(expanded)
+		if err != nil {
+			return fmt.Errorf("unable to index maven repository %q: %w", dir, err)
+		}
+		log.WithFields("dir", expanded, "artifacts", count).Info("indexed maven repository")
+	}
+
+	if opts.File == "" {
+		sb := &strings.Builder{}
+		if err := index.Write(sb); err != nil {
+			return err
+		}
+		bus.Report(sb.String())
+		return nil
+	}
+
+	return writeMavenIndex(opts.File, index)
+}
+
+func readExistingMavenIndex(opts mavenIndexOptions) (java.MavenIndex, error) {
+	if !opts.Merge || opts.File == "" {
+		return make(java.MavenIndex), nil
+	}
+
+	f, err := os.Open(opts.File)
+	if os.IsNotExist(err) {
+		return make(java.MavenIndex), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to open maven index: %w", err)
+	}
+	defer f.Close()
+
+	return java.ReadMavenIndex(f)
+}
+
+func writeMavenIndex(path string, index java.MavenIndex) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to create maven index: %w", err)
+	}
+
+	err = writeMavenIndexTo(f, index)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write maven index: %w", err)
+	}
+
+	return stderrPrintLnf("Wrote %d maven artifacts to %s", len(index), path)
+}
+
+func writeMavenIndexTo(w io.Writer, index java.MavenIndex) error {
+	if _, err := fmt.Fprintln(w, "# maven SHA1 index: <sha1> <groupId>:<artifactId>:<version>"); err != nil {
+		return err
+	}
+	return index.Write(w)
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMavenIndex(t *testing.T) {
+	repo := t.TempDir()
+	artifact := filepath.Join(repo, "org", "example", "lib", "1.0", "lib-1.0.jar")
+	require.NoError(t, os.MkdirAll(filepath.Dir(artifact), 0o755))
+	require.NoError(t, os.WriteFile(artifact, []byte("lib"), 0o600))
+
+	file := filepath.Join(t.TempDir(), "maven-index.txt")
+	require.NoError(t, os.WriteFile(file, []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa org.example:existing:2.0\n"), 0o600))
+
+	// merging keeps the entries already in the index
+	require.NoError(t, runMavenIndex(mavenIndexOptions{File: file, Merge: true}, []string{repo}))
+	contents, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "# maven SHA1 index: <sha1> <groupId>:<artifactId>:<version>\n"+
+		"9d062bafff17ba8b9a1215c4c51485134d509d91 org.example:lib:1.0\n"+
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa org.example:existing:2.0\n", string(contents))
+
+	// otherwise the index is replaced
+	require.NoError(t, runMavenIndex(mavenIndexOptions{File: file}, []string{repo}))
+	contents, err = os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, "# maven SHA1 index: <sha1> <groupId>:<artifactId>:<version>\n"+
+		"9d062bafff17ba8b9a1215c4c51485134d509d91 org.example:lib:1.0\n", string(contents))
+
+	assert.Error(t, runMavenIndex(mavenIndexOptions{File: file}, []string{filepath.Join(repo, "missing")}))
+}
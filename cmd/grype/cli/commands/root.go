@@ -3,6 +3,10 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -11,16 +15,26 @@ import (
 	"github.com/anchore/clio"
 	"github.com/anchore/grype/cmd/grype/cli/options"
 	"github.com/anchore/grype/grype"
+	"github.com/anchore/grype/grype/baseline"
 	"github.com/anchore/grype/grype/db/legacy/distribution"
 	v5 "github.com/anchore/grype/grype/db/v5"
 	"github.com/anchore/grype/grype/db/v5/matcher"
+	"github.com/anchore/grype/grype/db/v5/matcher/conan"
+	"github.com/anchore/grype/grype/db/v5/matcher/dart"
 	"github.com/anchore/grype/grype/db/v5/matcher/dotnet"
+	"github.com/anchore/grype/grype/db/v5/matcher/githubactions"
 	"github.com/anchore/grype/grype/db/v5/matcher/golang"
+	"github.com/anchore/grype/grype/db/v5/matcher/hex"
 	"github.com/anchore/grype/grype/db/v5/matcher/java"
 	"github.com/anchore/grype/grype/db/v5/matcher/javascript"
+	"github.com/anchore/grype/grype/db/v5/matcher/kernel"
+	"github.com/anchore/grype/grype/db/v5/matcher/nix"
+	"github.com/anchore/grype/grype/db/v5/matcher/php"
 	"github.com/anchore/grype/grype/db/v5/matcher/python"
 	"github.com/anchore/grype/grype/db/v5/matcher/ruby"
 	"github.com/anchore/grype/grype/db/v5/matcher/stock"
+	"github.com/anchore/grype/grype/db/v5/matcher/swift"
+	v6 "github.com/anchore/grype/grype/db/v6"
 	"github.com/anchore/grype/grype/event"
 	"github.com/anchore/grype/grype/event/parsers"
 	"github.com/anchore/grype/grype/grypeerr"
@@ -108,6 +122,10 @@ var ignoreLinuxKernelHeaders = []match.IgnoreRule{
 	{Package: match.IgnoreRulePackage{Name: "linux-libc-dev", UpstreamName: "linux", Type: string(syftPkg.DebPkg)}, MatchType: match.ExactIndirectMatch},
 }
 
+var ignoreUnreachableMatches = []match.IgnoreRule{
+	{Reachability: match.Unreachable},
+}
+
 //nolint:funlen
 func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs error) {
 	writer, err := format.MakeScanResultWriter(opts.Outputs, opts.File, format.PresentationConfig{
@@ -120,6 +138,7 @@ func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs
 
 	var str *v5.ProviderStore
 	var status *distribution.Status
+	var v6Status *v6.Status
 	var packages []pkg.Package
 	var s *sbom.SBOM
 	var pkgContext pkg.Context
@@ -136,6 +155,10 @@ func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs
 		opts.Ignore = append(opts.Ignore, ignoreLinuxKernelHeaders...)
 	}
 
+	if opts.Match.Golang.IgnoreUnreachable {
+		opts.Ignore = append(opts.Ignore, ignoreUnreachableMatches...)
+	}
+
 	for _, ignoreState := range stringutil.SplitCommaSeparatedString(opts.IgnoreStates) {
 		switch vulnerability.FixState(ignoreState) {
 		case vulnerability.FixStateUnknown, vulnerability.FixStateFixed, vulnerability.FixStateNotFixed, vulnerability.FixStateWontFix:
@@ -152,8 +175,8 @@ func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs
 		},
 		func() (err error) {
 			log.Debug("loading DB")
-			str, status, err = grype.LoadVulnerabilityDB(opts.DB.ToLegacyCuratorConfig(), opts.DB.AutoUpdate)
-			return validateDBLoad(err, status)
+			str, status, v6Status, err = loadVulnerabilityDB(opts)
+			return err
 		},
 		func() (err error) {
 			log.Debugf("gathering packages")
@@ -173,29 +196,61 @@ func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs
 		return err
 	}
 
-	defer log.CloseAndLogError(str, status.Location)
+	var dbStatus interface{} = status
+	dbLocation := ""
+	if v6Status != nil {
+		dbStatus = v6Status
+		dbLocation = v6Status.Path
+	} else if status != nil {
+		dbLocation = status.Location
+	}
+
+	defer log.CloseAndLogError(str, dbLocation)
 
 	if err = applyVexRules(opts); err != nil {
 		return fmt.Errorf("applying vex rules: %w", err)
 	}
 
+	var b *baseline.Baseline
+	if opts.Baseline != "" {
+		b, err = baseline.Read(opts.Baseline)
+		if err != nil {
+			return err
+		}
+	}
+
 	applyDistroHint(packages, &pkgContext, opts)
 
+	var metadataProvider vulnerability.MetadataProvider
+	if str.VulnerabilityMetadataProvider != nil {
+		metadataProvider = str
+	}
+
+	// the vulnerability record status rules only apply to matching, not to VEX processing (which is given opts.Ignore)
+	ignoreRules := slices.Concat(opts.Ignore, opts.Match.Status.IgnoreRules())
+
 	vulnMatcher := grype.VulnerabilityMatcher{
-		Store:          *str,
-		IgnoreRules:    opts.Ignore,
-		NormalizeByCVE: opts.ByCVE,
-		FailSeverity:   opts.FailOnSeverity(),
-		Matchers:       getMatchers(opts),
+		Store:              *str,
+		IgnoreRules:        ignoreRules,
+		NormalizeByCVE:     opts.ByCVE,
+		NormalizeBy:        opts.NormalizeByPreference(),
+		FailSeverity:       opts.FailOnSeverity(),
+		FailEPSSPercentile: opts.FailOnEPSSPercentile(),
+		FailOnKEV:          opts.FailOnKEV,
+		Matchers:           getMatchers(opts),
+		Parallelism:        matchParallelism(opts),
+		Baseline:           b,
+		FlaggedStatuses:    opts.Match.Status.FlaggedStatuses(),
 		VexProcessor: vex.NewProcessor(vex.ProcessorOptions{
-			Documents:   opts.VexDocuments,
-			IgnoreRules: opts.Ignore,
+			Documents:        opts.VexDocuments,
+			IgnoreRules:      opts.Ignore,
+			MetadataProvider: metadataProvider,
 		}),
 	}
 
 	remainingMatches, ignoredMatches, err := vulnMatcher.FindMatches(packages, pkgContext)
 	if err != nil {
-		if !errors.Is(err, grypeerr.ErrAboveSeverityThreshold) {
+		if !isFailOnErr(err) {
 			return err
 		}
 		errs = appendErrors(errs, err)
@@ -205,12 +260,14 @@ func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs
 		ID:               app.ID(),
 		Matches:          *remainingMatches,
 		IgnoredMatches:   ignoredMatches,
+		ResolvedMatches:  b.Resolved(*remainingMatches, ignoredMatches),
+		NotEvaluated:     vulnMatcher.NotEvaluated(),
 		Packages:         packages,
 		Context:          pkgContext,
 		MetadataProvider: str,
 		SBOM:             s,
 		AppConfig:        opts,
-		DBStatus:         status,
+		DBStatus:         dbStatus,
 	}); err != nil {
 		errs = appendErrors(errs, err)
 	}
@@ -218,6 +275,13 @@ func runGrype(app clio.Application, opts *options.Grype, userInput string) (errs
 	return errs
 }
 
+// isFailOnErr indicates if the given error is the result of a --fail-on* gate (which should not prevent reporting results)
+func isFailOnErr(err error) bool {
+	return errors.Is(err, grypeerr.ErrAboveSeverityThreshold) ||
+		errors.Is(err, grypeerr.ErrAboveEPSSThreshold) ||
+		errors.Is(err, grypeerr.ErrKnownExploitedVulnerability)
+}
+
 func applyDistroHint(pkgs []pkg.Package, context *pkg.Context, opts *options.Grype) {
 	if opts.Distro != "" {
 		log.Infof("using distro: %s", opts.Distro)
@@ -278,6 +342,13 @@ func checkForAppUpdate(id clio.Identification, opts *options.Grype) {
 	}
 }
 
+func matchParallelism(opts *options.Grype) int {
+	if opts.Match.Parallelism > 0 {
+		return opts.Match.Parallelism
+	}
+	return runtime.NumCPU()
+}
+
 func getMatchers(opts *options.Grype) []matcher.Matcher {
 	return matcher.NewDefaultMatchers(
 		matcher.Config{
@@ -294,7 +365,15 @@ func getMatchers(opts *options.Grype) []matcher.Matcher {
 				AlwaysUseCPEForStdlib:                  opts.Match.Golang.AlwaysUseCPEForStdlib,
 				AllowMainModulePseudoVersionComparison: opts.Match.Golang.AllowMainModulePseudoVersionComparison,
 			},
-			Stock: stock.MatcherConfig(opts.Match.Stock),
+			Php:           php.MatcherConfig(opts.Match.Php),
+			Swift:         swift.MatcherConfig(opts.Match.Swift),
+			Dart:          dart.MatcherConfig(opts.Match.Dart),
+			Hex:           hex.MatcherConfig(opts.Match.Hex),
+			Conan:         conan.MatcherConfig(opts.Match.Conan),
+			GithubActions: githubactions.MatcherConfig(opts.Match.GithubActions),
+			Kernel:        kernel.MatcherConfig(opts.Match.Kernel),
+			Nix:           nix.MatcherConfig(opts.Match.Nix),
+			Stock:         stock.MatcherConfig(opts.Match.Stock),
 		},
 	)
 }
@@ -317,6 +396,7 @@ func getProviderConfig(opts *options.Grype) pkg.ProviderConfig {
 			Platform:               opts.Platform,
 			Name:                   opts.Name,
 			DefaultImagePullSource: opts.DefaultImagePullSource,
+			ReadGolangSymbols:      reachabilityEnabled(opts),
 		},
 		SynthesisConfig: pkg.SynthesisConfig{
 			GenerateMissingCPEs: opts.GenerateMissingCPEs,
@@ -324,6 +404,85 @@ func getProviderConfig(opts *options.Grype) pkg.ProviderConfig {
 	}
 }
 
+// reachabilityEnabled indicates if the reachability of matches is used, either to ignore unreachable Go matches or by an
+// ignore rule, in which case the symbols of Go binaries need to be read.
+func reachabilityEnabled(opts *options.Grype) bool {
+	if opts.Match.Golang.IgnoreUnreachable {
+		return true
+	}
+	for _, rule := range opts.Ignore {
+		if rule.Reachability != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// loadVulnerabilityDB loads the v6 database when it has been requested or has already been installed (e.g. by
+// "grype db update" or "grype db import"), otherwise the v5 database is loaded. An installed v6 database that cannot be
+// loaded (and was not explicitly requested) falls back to the v5 database.
+func loadVulnerabilityDB(opts *options.Grype) (*v5.ProviderStore, *distribution.Status, *v6.Status, error) {
+	curatorCfg := opts.DB.ToCuratorConfig()
+	legacyCfg := opts.DB.ToLegacyCuratorConfig()
+	if useV6DB(opts.Experimental.DBv6, curatorCfg.DBFilePath(), legacyCfg) {
+		str, v6Status, err := grype.LoadVulnerabilityDBv6(opts.DB.ToClientConfig(), curatorCfg, opts.DB.AutoUpdate)
+		err = validateV6DBLoad(err, v6Status)
+		if err == nil {
+			log.WithFields("path", v6Status.Path, "schema", v6Status.SchemaVersion).Info("using the v6 vulnerability database")
+			return str, nil, v6Status, nil
+		}
+		if opts.Experimental.DBv6 {
+			return str, nil, v6Status, err
+		}
+		if str != nil {
+			log.CloseAndLogError(str.Closer, curatorCfg.DBFilePath())
+		}
+		log.WithFields("error", err).Warn("unable to load the v6 vulnerability database, falling back to the v5 database")
+	}
+
+	str, status, err := grype.LoadVulnerabilityDB(legacyCfg, opts.DB.AutoUpdate)
+	if err = validateDBLoad(err, status); err != nil {
+		return str, status, nil, err
+	}
+	log.WithFields("location", status.Location, "schema", status.SchemaVersion).Info("using the v5 vulnerability database")
+	return str, status, nil, nil
+}
+
+// useV6DB indicates if the v6 vulnerability database should be loaded. The v5 database is preferred while v6 support
+// is experimental, so v6 is only used when it is enabled explicitly or when it is installed without a valid v5 database.
+func useV6DB(experimental bool, v6DBPath string, legacyCfg distribution.Config) bool {
+	if experimental {
+		return true
+	}
+	if !fileExists(v6DBPath) {
+		return false
+	}
+
+	dbCurator, err := distribution.NewCurator(legacyCfg)
+	if err != nil {
+		log.WithFields("error", err).Debug("unable to check the v5 vulnerability database")
+		return true
+	}
+	status := dbCurator.Status()
+	switch {
+	case status.Err != nil:
+		log.WithFields("error", status.Err).Debug("no valid v5 vulnerability database installed")
+		return true
+	case status.SchemaVersion != v5.SchemaVersion:
+		log.WithFields("schema", status.SchemaVersion).Debug("installed v5 vulnerability database has an unsupported schema")
+		return true
+	case !fileExists(filepath.Join(status.Location, distribution.FileName)):
+		log.WithFields("location", status.Location).Debug("v5 vulnerability database is missing")
+		return true
+	}
+	return false
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
 func validateDBLoad(loadErr error, status *distribution.Status) error {
 	if loadErr != nil {
 		return fmt.Errorf("failed to load vulnerability db: %w", loadErr)
@@ -337,6 +496,19 @@ func validateDBLoad(loadErr error, status *distribution.Status) error {
 	return nil
 }
 
+func validateV6DBLoad(loadErr error, status *v6.Status) error {
+	if loadErr != nil {
+		return fmt.Errorf("failed to load vulnerability db: %w", loadErr)
+	}
+	if status == nil {
+		return fmt.Errorf("unable to determine the status of the vulnerability db")
+	}
+	if status.Err != nil {
+		return fmt.Errorf("db could not be loaded: %w", status.Err)
+	}
+	return nil
+}
+
 func validateRootArgs(cmd *cobra.Command, args []string) error {
 	isStdinPipeOrRedirect, err := internal.IsStdinPipeOrRedirect()
 	if err != nil {
@@ -1,14 +1,22 @@
 package commands
 
 import (
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/clio"
 	"github.com/anchore/grype/cmd/grype/cli/options"
+	"github.com/anchore/grype/grype/db/legacy/distribution"
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
 	"github.com/anchore/stereoscope/pkg/image"
 	"github.com/anchore/syft/syft"
@@ -73,6 +81,48 @@ func Test_getProviderConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "go binary symbols are read when unreachable matches are ignored",
+			opts: func() *options.Grype {
+				opts := options.DefaultGrype(clio.Identification{Name: "test", Version: "1.0"})
+				opts.Match.Golang.IgnoreUnreachable = true
+				return opts
+			}(),
+			want: pkg.ProviderConfig{
+				SyftProviderConfig: pkg.SyftProviderConfig{
+					SBOMOptions: func() *syft.CreateSBOMConfig {
+						cfg := syft.DefaultCreateSBOMConfig()
+						cfg.Compliance.MissingVersion = cataloging.ComplianceActionDrop
+						return cfg
+					}(),
+					RegistryOptions: &image.RegistryOptions{
+						Credentials: []image.RegistryCredentials{},
+					},
+					ReadGolangSymbols: true,
+				},
+			},
+		},
+		{
+			name: "go binary symbols are read when an ignore rule uses reachability",
+			opts: func() *options.Grype {
+				opts := options.DefaultGrype(clio.Identification{Name: "test", Version: "1.0"})
+				opts.Ignore = []match.IgnoreRule{{Reachability: match.Unreachable, Vulnerability: "GO-2023-1988"}}
+				return opts
+			}(),
+			want: pkg.ProviderConfig{
+				SyftProviderConfig: pkg.SyftProviderConfig{
+					SBOMOptions: func() *syft.CreateSBOMConfig {
+						cfg := syft.DefaultCreateSBOMConfig()
+						cfg.Compliance.MissingVersion = cataloging.ComplianceActionDrop
+						return cfg
+					}(),
+					RegistryOptions: &image.RegistryOptions{
+						Credentials: []image.RegistryCredentials{},
+					},
+					ReadGolangSymbols: true,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -86,3 +136,74 @@ func Test_getProviderConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_useV6DB(t *testing.T) {
+	installV5 := func(t *testing.T, root string, schema int) {
+		dir := filepath.Join(root, strconv.Itoa(v5.SchemaVersion))
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, distribution.FileName), []byte("db"), 0600))
+		m := distribution.Metadata{Built: time.Now(), Version: schema, Checksum: "sha256:abc"}
+		require.NoError(t, m.Write(filepath.Join(dir, distribution.MetadataFileName)))
+	}
+	installV6 := func(t *testing.T, root string) string {
+		p := filepath.Join(root, "v6", "vulnerability.db")
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte("db"), 0600))
+		return p
+	}
+
+	tests := []struct {
+		name         string
+		experimental bool
+		v5Schema     int
+		v6           bool
+		expected     bool
+	}{
+		{
+			name:     "no databases installed",
+			expected: false,
+		},
+		{
+			name:     "only v5 installed",
+			v5Schema: v5.SchemaVersion,
+			expected: false,
+		},
+		{
+			name:     "v5 is preferred when both are installed",
+			v5Schema: v5.SchemaVersion,
+			v6:       true,
+			expected: false,
+		},
+		{
+			name:     "only v6 installed",
+			v6:       true,
+			expected: true,
+		},
+		{
+			name:     "v5 with an unsupported schema",
+			v5Schema: v5.SchemaVersion - 1,
+			v6:       true,
+			expected: true,
+		},
+		{
+			name:         "experimental flag",
+			experimental: true,
+			v5Schema:     v5.SchemaVersion,
+			expected:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			if test.v5Schema != 0 {
+				installV5(t, root, test.v5Schema)
+			}
+			v6Path := filepath.Join(root, "v6", "vulnerability.db")
+			if test.v6 {
+				v6Path = installV6(t, root)
+			}
+
+			assert.Equal(t, test.expected, useV6DB(test.experimental, v6Path, distribution.Config{DBRootDir: root}))
+		})
+	}
+}
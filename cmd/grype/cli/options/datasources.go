@@ -1,6 +1,14 @@
 package options
 
 import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/adrg/xdg"
+	"github.com/mitchellh/go-homedir"
+
 	"github.com/anchore/clio"
 	"github.com/anchore/grype/grype/db/v5/matcher/java"
 )
@@ -16,22 +24,70 @@ type externalSources struct {
 
 var _ interface {
 	clio.FieldDescriber
+	clio.PostLoader
 } = (*externalSources)(nil)
 
 type maven struct {
 	SearchUpstreamBySha1 bool   `yaml:"search-upstream" json:"searchUpstreamBySha1" mapstructure:"search-maven-upstream"`
 	BaseURL              string `yaml:"base-url" json:"baseUrl" mapstructure:"base-url"`
+	Backend              string `yaml:"backend" json:"backend" mapstructure:"backend"`
+	IndexFile            string `yaml:"index-file" json:"indexFile" mapstructure:"index-file"`
+	Nexus                nexus  `yaml:"nexus" json:"nexus" mapstructure:"nexus"`
+	CacheDir             string `yaml:"cache-dir" json:"cacheDir" mapstructure:"cache-dir"`
 }
 
-func defaultExternalSources() externalSources {
+type nexus struct {
+	URL        string `yaml:"url" json:"url" mapstructure:"url"`
+	Repository string `yaml:"repository" json:"repository" mapstructure:"repository"`
+	// IMPORTANT: do not show the username or password in any output (sensitive information)
+	Username secret `yaml:"username" json:"username" mapstructure:"username"`
+	Password secret `yaml:"password" json:"password" mapstructure:"password"`
+}
+
+func defaultExternalSources(id clio.Identification) externalSources {
 	return externalSources{
 		Maven: maven{
 			SearchUpstreamBySha1: true,
 			BaseURL:              defaultMavenBaseURL,
+			Backend:              java.MavenSearchBackend,
+			CacheDir:             path.Join(xdg.CacheHome, id.Name, "maven"),
 		},
 	}
 }
 
+func (cfg *externalSources) PostLoad() error {
+	for _, p := range []*string{&cfg.Maven.IndexFile, &cfg.Maven.CacheDir} {
+		expanded, err := homedir.Expand(*p)
+		if err != nil {
+			return fmt.Errorf("unable to expand path %q: %w", *p, err)
+		}
+		*p = expanded
+	}
+
+	cfg.Maven.Backend = strings.ToLower(strings.TrimSpace(cfg.Maven.Backend))
+	switch cfg.Maven.Backend {
+	case "":
+		cfg.Maven.Backend = java.MavenSearchBackend
+	case java.MavenSearchBackend:
+	case java.MavenIndexBackend:
+		if cfg.Maven.IndexFile == "" {
+			return fmt.Errorf("external-sources.maven.index-file must be set when using the %q backend", java.MavenIndexBackend)
+		}
+		if cfg.Enable && cfg.Maven.SearchUpstreamBySha1 {
+			if _, err := os.Stat(cfg.Maven.IndexFile); err != nil {
+				return fmt.Errorf("unable to read maven index file: %w", err)
+			}
+		}
+	case java.MavenNexusBackend:
+		if cfg.Maven.Nexus.URL == "" {
+			return fmt.Errorf("external-sources.maven.nexus.url must be set when using the %q backend", java.MavenNexusBackend)
+		}
+	default:
+		return fmt.Errorf("invalid maven backend %q (allowable: %s)", cfg.Maven.Backend, strings.Join(java.MavenBackends, ", "))
+	}
+	return nil
+}
+
 func (cfg externalSources) ToJavaMatcherConfig() java.ExternalSearchConfig {
 	// always respect if global config is disabled
 	smu := cfg.Maven.SearchUpstreamBySha1
@@ -41,6 +97,15 @@ func (cfg externalSources) ToJavaMatcherConfig() java.ExternalSearchConfig {
 	return java.ExternalSearchConfig{
 		SearchMavenUpstream: smu,
 		MavenBaseURL:        cfg.Maven.BaseURL,
+		MavenBackend:        cfg.Maven.Backend,
+		MavenIndexFile:      cfg.Maven.IndexFile,
+		MavenNexus: java.NexusConfig{
+			URL:        cfg.Maven.Nexus.URL,
+			Repository: cfg.Maven.Nexus.Repository,
+			Username:   cfg.Maven.Nexus.Username.String(),
+			Password:   cfg.Maven.Nexus.Password.String(),
+		},
+		MavenCacheDir: cfg.Maven.CacheDir,
 	}
 }
 
@@ -48,4 +113,14 @@ func (cfg *externalSources) DescribeFields(descriptions clio.FieldDescriptionSet
 	descriptions.Add(&cfg.Enable, `enable Grype searching network source for additional information`)
 	descriptions.Add(&cfg.Maven.SearchUpstreamBySha1, `search for Maven artifacts by SHA1`)
 	descriptions.Add(&cfg.Maven.BaseURL, `base URL of the Maven repository to search`)
+	descriptions.Add(&cfg.Maven.Backend, fmt.Sprintf(`how Maven artifacts are looked up by SHA1 (allowable: %s):
+- search: the search API at base-url (e.g. search.maven.org)
+- index: a local SHA1 index file (see "grype maven index"), for use without network access
+- nexus: the search API of a Nexus Repository 3 server`, strings.Join(java.MavenBackends, ", ")))
+	descriptions.Add(&cfg.Maven.IndexFile, `path to the SHA1 index file used by the "index" backend`)
+	descriptions.Add(&cfg.Maven.Nexus.URL, `URL of the Nexus server used by the "nexus" backend (e.g. "https://nexus.example.com")`)
+	descriptions.Add(&cfg.Maven.Nexus.Repository, `restrict the Nexus search to a single repository (optional)`)
+	descriptions.Add(&cfg.Maven.Nexus.Username, `username for the Nexus server (optional)`)
+	descriptions.Add(&cfg.Maven.Nexus.Password, `password for the Nexus server (optional)`)
+	descriptions.Add(&cfg.Maven.CacheDir, `directory where SHA1 lookup results from the search and nexus backends are cached between runs (caching is disabled when empty)`)
 }
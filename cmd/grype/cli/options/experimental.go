@@ -19,5 +19,6 @@ var _ interface {
 } = (*Experimental)(nil)
 
 func (cfg *Experimental) DescribeFields(descriptions clio.FieldDescriptionSet) {
-	descriptions.Add(&cfg.DBv6, `use the v6 database schema`)
+	descriptions.Add(&cfg.DBv6, `always use the v6 database schema (otherwise the v5 schema is used for scans, unless a v6 database has been
+installed without a valid v5 database)`)
 }
@@ -2,6 +2,8 @@ package options
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/anchore/clio"
 	"github.com/anchore/grype/grype/match"
@@ -28,13 +30,17 @@ type Grype struct {
 	ExternalSources            externalSources    `yaml:"external-sources" json:"externalSources" mapstructure:"external-sources"`
 	Match                      matchConfig        `yaml:"match" json:"match" mapstructure:"match"`
 	FailOn                     string             `yaml:"fail-on-severity" json:"fail-on-severity" mapstructure:"fail-on-severity"`
+	FailOnEPSS                 float64            `yaml:"fail-on-epss" json:"fail-on-epss" mapstructure:"fail-on-epss"` // --fail-on-epss, the EPSS percentile (0-1) at or above which grype should exit with a non-zero return code
+	FailOnKEV                  bool               `yaml:"fail-on-kev" json:"fail-on-kev" mapstructure:"fail-on-kev"`    // --fail-on-kev, exit with a non-zero return code if any match is in the CISA KEV catalog
 	Registry                   registry           `yaml:"registry" json:"registry" mapstructure:"registry"`
 	ShowSuppressed             bool               `yaml:"show-suppressed" json:"show-suppressed" mapstructure:"show-suppressed"`
 	ByCVE                      bool               `yaml:"by-cve" json:"by-cve" mapstructure:"by-cve"` // --by-cve, indicates if the original match vulnerability IDs should be preserved or the CVE should be used instead
+	NormalizeBy                string             `yaml:"normalize-by" json:"normalize-by" mapstructure:"normalize-by"`
 	Name                       string             `yaml:"name" json:"name" mapstructure:"name"`
 	DefaultImagePullSource     string             `yaml:"default-image-pull-source" json:"default-image-pull-source" mapstructure:"default-image-pull-source"`
 	VexDocuments               []string           `yaml:"vex-documents" json:"vex-documents" mapstructure:"vex-documents"`
 	VexAdd                     []string           `yaml:"vex-add" json:"vex-add" mapstructure:"vex-add"`                                                                   // GRYPE_VEX_ADD
+	Baseline                   string             `yaml:"baseline" json:"baseline" mapstructure:"baseline"`                                                                // --baseline, a previous grype JSON report to compare results against
 	MatchUpstreamKernelHeaders bool               `yaml:"match-upstream-kernel-headers" json:"match-upstream-kernel-headers" mapstructure:"match-upstream-kernel-headers"` // Show matches on kernel-headers packages where the match is on kernel upstream instead of marking them as ignored, default=false
 	Experimental               Experimental       `yaml:"exp" json:"exp" mapstructure:"exp"`
 }
@@ -50,7 +56,7 @@ func DefaultGrype(id clio.Identification) *Grype {
 		Search:                     defaultSearch(source.SquashedScope),
 		DB:                         DefaultDatabase(id),
 		Match:                      defaultMatchConfig(),
-		ExternalSources:            defaultExternalSources(),
+		ExternalSources:            defaultExternalSources(id),
 		CheckForAppUpdate:          true,
 		VexAdd:                     []string{},
 		MatchUpstreamKernelHeaders: false,
@@ -98,6 +104,16 @@ func (o *Grype) AddFlags(flags clio.FlagSet) {
 		fmt.Sprintf("set the return code to 1 if a vulnerability is found with a severity >= the given severity, options=%v", vulnerability.AllSeverities()),
 	)
 
+	flags.Float64VarP(&o.FailOnEPSS,
+		"fail-on-epss", "",
+		"set the return code to 1 if a vulnerability is found with an EPSS percentile >= the given percentile (a value between 0 and 1)",
+	)
+
+	flags.BoolVarP(&o.FailOnKEV,
+		"fail-on-kev", "",
+		"set the return code to 1 if a vulnerability is found that is in the CISA known exploited vulnerabilities (KEV) catalog",
+	)
+
 	flags.BoolVarP(&o.OnlyFixed,
 		"only-fixed", "",
 		"ignore matches for vulnerabilities that are not fixed",
@@ -118,6 +134,11 @@ func (o *Grype) AddFlags(flags clio.FlagSet) {
 		"orient results by CVE instead of the original vulnerability ID when possible",
 	)
 
+	flags.StringVarP(&o.NormalizeBy,
+		"normalize-by", "",
+		"orient results by the first of the given comma separated vulnerability ID prefixes with a known alias instead of the original vulnerability ID (e.g. 'ghsa,cve'), takes precedence over --by-cve",
+	)
+
 	flags.BoolVarP(&o.ShowSuppressed,
 		"show-suppressed", "",
 		"show suppressed/ignored vulnerabilities in the output (only supported with table output format)",
@@ -137,6 +158,11 @@ func (o *Grype) AddFlags(flags clio.FlagSet) {
 		"vex", "",
 		"a list of VEX documents to consider when producing scanning results",
 	)
+
+	flags.StringVarP(&o.Baseline,
+		"baseline", "",
+		"a previous grype JSON report to compare against, only new or changed findings are reported (and considered for --fail-on)",
+	)
 }
 
 func (o *Grype) PostLoad() error {
@@ -146,6 +172,17 @@ func (o *Grype) PostLoad() error {
 			return fmt.Errorf("bad --fail-on severity value '%s'", o.FailOn)
 		}
 	}
+	if o.FailOnEPSS < 0 || o.FailOnEPSS > 1 {
+		return fmt.Errorf("bad --fail-on-epss percentile value '%v' (must be between 0 and 1)", o.FailOnEPSS)
+	}
+	for _, rule := range o.Ignore {
+		if _, err := rule.ExpiresAt(); err != nil {
+			return err
+		}
+		if err := rule.Severity.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -168,6 +205,10 @@ write output report to a file (default is to write to stdout)`)
 when using template as the output type, you must also provide a value for 'output-template-file'`)
 	descriptions.Add(&o.FailOn, `upon scanning, if a severity is found at or above the given severity then the return code will be 1
 default is unset which will skip this validation (options: negligible, low, medium, high, critical)`)
+	descriptions.Add(&o.FailOnEPSS, `upon scanning, if a vulnerability is found with an EPSS percentile at or above the given value (0-1) then the return code will be 1
+default is unset (0) which will skip this validation`)
+	descriptions.Add(&o.FailOnKEV, `upon scanning, if a vulnerability is found that is in the CISA known exploited vulnerabilities (KEV) catalog then the return code will be 1
+(only matches remaining after ignore rules are applied are considered)`)
 	descriptions.Add(&o.Ignore, `A list of vulnerability ignore rules, one or more property may be specified and all matching vulnerabilities will be ignored.
 This is the full set of supported rule fields:
   - vulnerability: CVE-2008-4318
@@ -177,15 +218,55 @@ This is the full set of supported rule fields:
       version: 1.5.1
       type: npm
       location: "/usr/local/lib/node_modules/**"
+      # globs ("*" matches any characters) against the package URL and CPEs of the package
+      purl: "pkg:npm/*"
+      cpe: "cpe:2.3:a:curl:*"
+      # a version constraint in the version format of the package
+      version-constraint: "< 2.0"
+    # an inclusive range of severities, either bound is optional
+    severity:
+      min: negligible
+      max: low
+    # the highest CVSS base score of the vulnerability must be at or below this value
+    max-cvss: 3.9
+    # rules may be time-boxed: once expired (after the given date or RFC 3339 timestamp) the rule no longer
+    # suppresses matches and the matches are reported with an "expired ignore" status instead
+    expires: "2025-06-30"  # note: dates must be quoted
+    owner: security-team
 
 VEX fields apply when Grype reads vex data:
   - vex-status: not_affected
     vex-justification: vulnerable_code_not_present
 `)
+	descriptions.Add(&o.NormalizeBy, `same as --normalize-by; an ordered, comma-separated list of vulnerability ID prefixes (e.g. "ghsa,cve"). Each match
+is reported under the first preferred ID that is known to be an alias of the matched vulnerability, otherwise the original ID
+is kept. Ignore rules written against any alias still apply. This takes precedence over by-cve (which is the same as "cve")`)
 	descriptions.Add(&o.VexAdd, `VEX statuses to consider as ignored rules`)
+	descriptions.Add(&o.Baseline, `a previous grype JSON report to compare results against. Findings that are unchanged since the baseline are
+suppressed (and do not trigger any fail-on gates), and findings from the baseline that are no longer found are listed as resolved`)
 	descriptions.Add(&o.MatchUpstreamKernelHeaders, `match kernel-header packages with upstream kernel as kernel vulnerabilities`)
 }
 
+// NormalizeByPreference returns the ordered vulnerability ID prefixes that results are oriented by.
+func (o Grype) NormalizeByPreference() []string {
+	var prefixes []string
+	for _, prefix := range strings.Split(o.NormalizeBy, ",") {
+		prefix = strings.ToLower(strings.TrimSpace(prefix))
+		if prefix != "" && !slices.Contains(prefixes, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+func (o Grype) FailOnEPSSPercentile() *float64 {
+	if o.FailOnEPSS <= 0 {
+		return nil
+	}
+	percentile := o.FailOnEPSS
+	return &percentile
+}
+
 func (o Grype) FailOnSeverity() *vulnerability.Severity {
 	severity := vulnerability.ParseSeverity(o.FailOn)
 	return &severity
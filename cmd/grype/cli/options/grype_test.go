@@ -0,0 +1,12 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrype_NormalizeByPreference(t *testing.T) {
+	assert.Empty(t, Grype{}.NormalizeByPreference())
+	assert.Equal(t, []string{"ghsa", "cve"}, Grype{NormalizeBy: " GHSA, cve,,ghsa "}.NormalizeByPreference())
+}
@@ -1,22 +1,40 @@
 package options
 
-import "github.com/anchore/clio"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/vulnerability"
+)
 
 // matchConfig contains all matching-related configuration options available to the user via the application config.
 type matchConfig struct {
-	Java       matcherConfig `yaml:"java" json:"java" mapstructure:"java"`                   // settings for the java matcher
-	JVM        matcherConfig `yaml:"jvm" json:"jvm" mapstructure:"jvm"`                      // settings for the jvm matcher
-	Dotnet     matcherConfig `yaml:"dotnet" json:"dotnet" mapstructure:"dotnet"`             // settings for the dotnet matcher
-	Golang     golangConfig  `yaml:"golang" json:"golang" mapstructure:"golang"`             // settings for the golang matcher
-	Javascript matcherConfig `yaml:"javascript" json:"javascript" mapstructure:"javascript"` // settings for the javascript matcher
-	Python     matcherConfig `yaml:"python" json:"python" mapstructure:"python"`             // settings for the python matcher
-	Ruby       matcherConfig `yaml:"ruby" json:"ruby" mapstructure:"ruby"`                   // settings for the ruby matcher
-	Rust       matcherConfig `yaml:"rust" json:"rust" mapstructure:"rust"`                   // settings for the rust matcher
-	Stock      matcherConfig `yaml:"stock" json:"stock" mapstructure:"stock"`                // settings for the default/stock matcher
+	Java          matcherConfig `yaml:"java" json:"java" mapstructure:"java"`                               // settings for the java matcher
+	JVM           matcherConfig `yaml:"jvm" json:"jvm" mapstructure:"jvm"`                                  // settings for the jvm matcher
+	Dotnet        matcherConfig `yaml:"dotnet" json:"dotnet" mapstructure:"dotnet"`                         // settings for the dotnet matcher
+	Golang        golangConfig  `yaml:"golang" json:"golang" mapstructure:"golang"`                         // settings for the golang matcher
+	Javascript    matcherConfig `yaml:"javascript" json:"javascript" mapstructure:"javascript"`             // settings for the javascript matcher
+	Python        matcherConfig `yaml:"python" json:"python" mapstructure:"python"`                         // settings for the python matcher
+	Ruby          matcherConfig `yaml:"ruby" json:"ruby" mapstructure:"ruby"`                               // settings for the ruby matcher
+	Rust          matcherConfig `yaml:"rust" json:"rust" mapstructure:"rust"`                               // settings for the rust matcher
+	Php           matcherConfig `yaml:"php" json:"php" mapstructure:"php"`                                  // settings for the php composer matcher
+	Swift         matcherConfig `yaml:"swift" json:"swift" mapstructure:"swift"`                            // settings for the swift and cocoapods matcher
+	Dart          matcherConfig `yaml:"dart" json:"dart" mapstructure:"dart"`                               // settings for the dart pub matcher
+	Hex           matcherConfig `yaml:"hex" json:"hex" mapstructure:"hex"`                                  // settings for the erlang/elixir hex matcher
+	Conan         matcherConfig `yaml:"conan" json:"conan" mapstructure:"conan"`                            // settings for the c/c++ conan matcher
+	GithubActions matcherConfig `yaml:"github-actions" json:"github-actions" mapstructure:"github-actions"` // settings for the github actions matcher
+	Kernel        matcherConfig `yaml:"kernel" json:"kernel" mapstructure:"kernel"`                         // settings for the linux kernel matcher
+	Nix           matcherConfig `yaml:"nix" json:"nix" mapstructure:"nix"`                                  // settings for the nix matcher
+	Stock         matcherConfig `yaml:"stock" json:"stock" mapstructure:"stock"`                            // settings for the default/stock matcher
+	Parallelism   int           `yaml:"parallelism" json:"parallelism" mapstructure:"parallelism"`          // number of packages to search for matches concurrently
+	Status        statusConfig  `yaml:"status" json:"status" mapstructure:"status"`                         // how matches are reported based on the status of the vulnerability record
 }
 
 var _ interface {
 	clio.FieldDescriber
+	clio.PostLoader
 } = (*matchConfig)(nil)
 
 type matcherConfig struct {
@@ -27,6 +45,81 @@ type golangConfig struct {
 	matcherConfig                          `yaml:",inline" mapstructure:",squash"`
 	AlwaysUseCPEForStdlib                  bool `yaml:"always-use-cpe-for-stdlib" json:"always-use-cpe-for-stdlib" mapstructure:"always-use-cpe-for-stdlib"`                                                       // if CPEs should be used during matching
 	AllowMainModulePseudoVersionComparison bool `yaml:"allow-main-module-pseudo-version-comparison" json:"allow-main-module-pseudo-version-comparison" mapstructure:"allow-main-module-pseudo-version-comparison"` // if pseudo versions should be compared
+	IgnoreUnreachable                      bool `yaml:"ignore-unreachable" json:"ignore-unreachable" mapstructure:"ignore-unreachable"`                                                                            // if matches where the vulnerable symbols are not linked into the binary should be ignored
+}
+
+const (
+	statusInclude = "include" // report the match as any other
+	statusFlag    = "flag"    // report the match, calling out the status of the vulnerability record
+	statusDrop    = "drop"    // ignore the match
+)
+
+var statusActions = []string{statusInclude, statusFlag, statusDrop}
+
+// statusConfig is the action (include, flag or drop) taken for matches against vulnerability records with each
+// non-active status.
+type statusConfig struct {
+	Analyzing string `yaml:"analyzing" json:"analyzing" mapstructure:"analyzing"`
+	Disputed  string `yaml:"disputed" json:"disputed" mapstructure:"disputed"`
+	Rejected  string `yaml:"rejected" json:"rejected" mapstructure:"rejected"`
+	Withdrawn string `yaml:"withdrawn" json:"withdrawn" mapstructure:"withdrawn"`
+}
+
+func defaultStatusConfig() statusConfig {
+	return statusConfig{
+		Analyzing: statusInclude,
+		Disputed:  statusFlag,
+		Rejected:  statusDrop,
+		Withdrawn: statusDrop,
+	}
+}
+
+func (cfg statusConfig) actions() map[vulnerability.Status]string {
+	return map[vulnerability.Status]string{
+		vulnerability.StatusAnalyzing: cfg.Analyzing,
+		vulnerability.StatusDisputed:  cfg.Disputed,
+		vulnerability.StatusRejected:  cfg.Rejected,
+		vulnerability.StatusWithdrawn: cfg.Withdrawn,
+	}
+}
+
+// IgnoreRules returns the rules that ignore matches for vulnerability records with a status that should be dropped.
+func (cfg statusConfig) IgnoreRules() []match.IgnoreRule {
+	var rules []match.IgnoreRule
+	for _, status := range vulnerability.AllStatuses() {
+		if cfg.actions()[status] == statusDrop {
+			rules = append(rules, match.IgnoreRule{
+				VulnerabilityStatus: string(status),
+				Reason:              fmt.Sprintf("the vulnerability record is %s", status),
+			})
+		}
+	}
+	return rules
+}
+
+// FlaggedStatuses returns the statuses of vulnerability records that should be called out when reporting matches.
+func (cfg statusConfig) FlaggedStatuses() []vulnerability.Status {
+	var statuses []vulnerability.Status
+	for _, status := range vulnerability.AllStatuses() {
+		if cfg.actions()[status] == statusFlag {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
+
+func (cfg *statusConfig) normalize() error {
+	for _, action := range []*string{&cfg.Analyzing, &cfg.Disputed, &cfg.Rejected, &cfg.Withdrawn} {
+		*action = strings.ToLower(strings.TrimSpace(*action))
+		switch *action {
+		case "":
+			*action = statusInclude
+		case statusInclude, statusFlag, statusDrop:
+		default:
+			return fmt.Errorf("invalid match.status action %q (allowable: %s)", *action, strings.Join(statusActions, ", "))
+		}
+	}
+	return nil
 }
 
 func defaultGolangConfig() golangConfig {
@@ -36,6 +129,7 @@ func defaultGolangConfig() golangConfig {
 		},
 		AlwaysUseCPEForStdlib:                  true,
 		AllowMainModulePseudoVersionComparison: false,
+		IgnoreUnreachable:                      false,
 	}
 }
 
@@ -43,18 +137,31 @@ func defaultMatchConfig() matchConfig {
 	useCpe := matcherConfig{UseCPEs: true}
 	dontUseCpe := matcherConfig{UseCPEs: false}
 	return matchConfig{
-		Java:       dontUseCpe,
-		JVM:        useCpe,
-		Dotnet:     dontUseCpe,
-		Golang:     defaultGolangConfig(),
-		Javascript: dontUseCpe,
-		Python:     dontUseCpe,
-		Ruby:       dontUseCpe,
-		Rust:       dontUseCpe,
-		Stock:      useCpe,
+		Java:          dontUseCpe,
+		JVM:           useCpe,
+		Dotnet:        dontUseCpe,
+		Golang:        defaultGolangConfig(),
+		Javascript:    dontUseCpe,
+		Python:        dontUseCpe,
+		Ruby:          dontUseCpe,
+		Rust:          dontUseCpe,
+		Php:           dontUseCpe,
+		Swift:         dontUseCpe,
+		Dart:          dontUseCpe,
+		Hex:           dontUseCpe,
+		Conan:         dontUseCpe,
+		GithubActions: dontUseCpe,
+		Kernel:        dontUseCpe,
+		Nix:           useCpe,
+		Stock:         useCpe,
+		Status:        defaultStatusConfig(),
 	}
 }
 
+func (cfg *matchConfig) PostLoad() error {
+	return cfg.Status.normalize()
+}
+
 func (cfg *matchConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
 	usingCpeDescription := `use CPE matching to find vulnerabilities`
 	descriptions.Add(&cfg.Java.UseCPEs, usingCpeDescription)
@@ -62,9 +169,24 @@ func (cfg *matchConfig) DescribeFields(descriptions clio.FieldDescriptionSet) {
 	descriptions.Add(&cfg.Golang.UseCPEs, usingCpeDescription)
 	descriptions.Add(&cfg.Golang.AlwaysUseCPEForStdlib, usingCpeDescription+" for the Go standard library")
 	descriptions.Add(&cfg.Golang.AllowMainModulePseudoVersionComparison, `allow comparison between main module pseudo-versions (e.g. v0.0.0-20240413-2b432cf643...)`)
+	descriptions.Add(&cfg.Golang.IgnoreUnreachable, `ignore matches for Go binaries where none of the vulnerable functions are linked into the binary (matches where this is unknown are kept)`)
 	descriptions.Add(&cfg.Javascript.UseCPEs, usingCpeDescription)
 	descriptions.Add(&cfg.Python.UseCPEs, usingCpeDescription)
 	descriptions.Add(&cfg.Ruby.UseCPEs, usingCpeDescription)
 	descriptions.Add(&cfg.Rust.UseCPEs, usingCpeDescription)
+	descriptions.Add(&cfg.Php.UseCPEs, usingCpeDescription)
+	descriptions.Add(&cfg.Swift.UseCPEs, usingCpeDescription)
+	descriptions.Add(&cfg.Dart.UseCPEs, usingCpeDescription)
+	descriptions.Add(&cfg.Hex.UseCPEs, usingCpeDescription)
+	descriptions.Add(&cfg.Conan.UseCPEs, `use CPE matching for conan packages without a known upstream project (otherwise these packages are reported as not evaluated)`)
+	descriptions.Add(&cfg.GithubActions.UseCPEs, usingCpeDescription)
+	descriptions.Add(&cfg.Kernel.UseCPEs, `use CPE matching for vulnerabilities that the kernel.org CNA has not published affected ranges for`)
+	descriptions.Add(&cfg.Nix.UseCPEs, `use CPE matching (by the package name without the store path hash) for nix packages that are not in the table of known upstream projects (otherwise these packages are reported as not evaluated)`)
 	descriptions.Add(&cfg.Stock.UseCPEs, usingCpeDescription)
+	statusDescription := fmt.Sprintf(`how matches are reported for %%s vulnerability records (allowable: %s)`, strings.Join(statusActions, ", "))
+	descriptions.Add(&cfg.Status.Analyzing, fmt.Sprintf(statusDescription, "analyzing (under review)"))
+	descriptions.Add(&cfg.Status.Disputed, fmt.Sprintf(statusDescription, "disputed"))
+	descriptions.Add(&cfg.Status.Rejected, fmt.Sprintf(statusDescription, "rejected"))
+	descriptions.Add(&cfg.Status.Withdrawn, fmt.Sprintf(statusDescription, "withdrawn"))
+	descriptions.Add(&cfg.Parallelism, `the number of packages to search for vulnerability matches concurrently (0 = use the number of available CPUs)`)
 }
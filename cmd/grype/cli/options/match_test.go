@@ -0,0 +1,33 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+func TestStatusConfig(t *testing.T) {
+	cfg := defaultStatusConfig()
+	require.NoError(t, cfg.normalize())
+
+	assert.Equal(t, []match.IgnoreRule{
+		{VulnerabilityStatus: "rejected", Reason: "the vulnerability record is rejected"},
+		{VulnerabilityStatus: "withdrawn", Reason: "the vulnerability record is withdrawn"},
+	}, cfg.IgnoreRules())
+	assert.Equal(t, []vulnerability.Status{vulnerability.StatusDisputed}, cfg.FlaggedStatuses())
+
+	cfg = statusConfig{Analyzing: " Flag ", Disputed: "drop"}
+	require.NoError(t, cfg.normalize())
+	assert.Equal(t, statusConfig{Analyzing: "flag", Disputed: "drop", Rejected: "include", Withdrawn: "include"}, cfg)
+	assert.Equal(t, []match.IgnoreRule{
+		{VulnerabilityStatus: "disputed", Reason: "the vulnerability record is disputed"},
+	}, cfg.IgnoreRules())
+	assert.Equal(t, []vulnerability.Status{vulnerability.StatusAnalyzing}, cfg.FlaggedStatuses())
+
+	cfg = statusConfig{Rejected: "hide"}
+	assert.Error(t, cfg.normalize())
+}
@@ -0,0 +1,158 @@
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/grype/internal/log"
+)
+
+// Namespace is the namespace of the ignore rule attached to matches that are unchanged since the baseline
+const Namespace = "baseline"
+
+var ignoreRule = match.IgnoreRule{
+	Namespace: Namespace,
+	Reason:    "finding is present in the baseline",
+}
+
+// Baseline is the set of findings from a previous scan that the results of a new scan are compared against, so that
+// only new (or changed) findings are reported.
+type Baseline struct {
+	matches []models.Match
+
+	// fingerprints are the fingerprints of all baseline findings
+	fingerprints map[match.Fingerprint]struct{}
+
+	// findings identify all baseline findings regardless of fix information
+	findings map[findingID]struct{}
+}
+
+// Read loads a baseline from a grype JSON report.
+func Read(path string) (*Baseline, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open baseline: %w", err)
+	}
+	defer f.Close()
+
+	var doc models.Document
+	if err := json.NewDecoder(f).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode baseline %q (expected a grype JSON report): %w", path, err)
+	}
+
+	return New(doc), nil
+}
+
+// New creates a baseline from the matches in a grype JSON document.
+func New(doc models.Document) *Baseline {
+	b := &Baseline{
+		matches:      doc.Matches,
+		fingerprints: make(map[match.Fingerprint]struct{}),
+		findings:     make(map[findingID]struct{}),
+	}
+	for _, m := range doc.Matches {
+		b.fingerprints[Fingerprint(m)] = struct{}{}
+		b.findings[findingIDFromModel(m)] = struct{}{}
+	}
+	return b
+}
+
+// Fingerprint returns the match fingerprint for a finding from a grype JSON document.
+func Fingerprint(m models.Match) match.Fingerprint {
+	return match.Match{
+		Vulnerability: vulnerability.Vulnerability{
+			Reference: vulnerability.Reference{
+				ID:        m.Vulnerability.ID,
+				Namespace: m.Vulnerability.Namespace,
+			},
+			Fix: vulnerability.Fix{
+				Versions: m.Vulnerability.Fix.Versions,
+			},
+		},
+		Package: pkg.Package{
+			ID: pkg.ID(m.Artifact.ID),
+		},
+	}.Fingerprint()
+}
+
+// Apply moves any matches that are unchanged since the baseline to the ignored matches. The remaining matches are
+// only those that are new or have changed since the baseline.
+func (b *Baseline) Apply(matches match.Matches, ignoredMatches []match.IgnoredMatch) (match.Matches, []match.IgnoredMatch) {
+	if b == nil {
+		return matches, ignoredMatches
+	}
+
+	var added, changed int
+	remaining := match.NewMatches()
+	for _, m := range matches.Sorted() {
+		if _, ok := b.fingerprints[m.Fingerprint()]; !ok {
+			if _, ok := b.findings[findingIDFromMatch(m)]; ok {
+				changed++
+			} else {
+				added++
+			}
+			remaining.Add(m)
+			continue
+		}
+		ignoredMatches = append(ignoredMatches, match.IgnoredMatch{
+			Match:              m,
+			AppliedIgnoreRules: []match.IgnoreRule{ignoreRule},
+		})
+	}
+
+	log.WithFields("new", added, "changed", changed, "unchanged", matches.Count()-added-changed).Debug("compared matches against baseline")
+
+	return remaining, ignoredMatches
+}
+
+// Resolved returns the baseline findings that are no longer found (either reported or ignored) by the current scan.
+func (b *Baseline) Resolved(matches match.Matches, ignoredMatches []match.IgnoredMatch) []models.Match {
+	if b == nil {
+		return nil
+	}
+
+	current := make(map[findingID]struct{})
+	for m := range matches.Enumerate() {
+		current[findingIDFromMatch(m)] = struct{}{}
+	}
+	for _, m := range ignoredMatches {
+		current[findingIDFromMatch(m.Match)] = struct{}{}
+	}
+
+	var resolved []models.Match
+	for _, m := range b.matches {
+		if _, ok := current[findingIDFromModel(m)]; !ok {
+			resolved = append(resolved, m)
+		}
+	}
+	return resolved
+}
+
+// findingID identifies a finding regardless of the fix information, so that a finding which has changed since the
+// baseline is not considered to be resolved
+type findingID struct {
+	vulnerabilityID        string
+	vulnerabilityNamespace string
+	packageID              pkg.ID
+}
+
+func findingIDFromMatch(m match.Match) findingID {
+	return findingID{
+		vulnerabilityID:        m.Vulnerability.ID,
+		vulnerabilityNamespace: m.Vulnerability.Namespace,
+		packageID:              m.Package.ID,
+	}
+}
+
+func findingIDFromModel(m models.Match) findingID {
+	return findingID{
+		vulnerabilityID:        m.Vulnerability.ID,
+		vulnerabilityNamespace: m.Vulnerability.Namespace,
+		packageID:              pkg.ID(m.Artifact.ID),
+	}
+}
@@ -0,0 +1,106 @@
+package baseline
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+func newMatch(vulnID string, packageID pkg.ID, fixes ...string) match.Match {
+	return match.Match{
+		Vulnerability: vulnerability.Vulnerability{
+			Reference: vulnerability.Reference{ID: vulnID, Namespace: "nvd:cpe"},
+			Fix:       vulnerability.Fix{Versions: fixes},
+		},
+		Package: pkg.Package{ID: packageID, Name: string(packageID)},
+	}
+}
+
+func newModel(vulnID string, packageID pkg.ID, fixes ...string) models.Match {
+	m := models.Match{
+		Artifact: models.Package{ID: string(packageID), Name: string(packageID)},
+	}
+	m.Vulnerability.ID = vulnID
+	m.Vulnerability.Namespace = "nvd:cpe"
+	m.Vulnerability.Fix.Versions = fixes
+	return m
+}
+
+func TestFingerprint(t *testing.T) {
+	assert.Equal(t, newMatch("CVE-2024-0001", "pkg-a", "1.2.3").Fingerprint(), Fingerprint(newModel("CVE-2024-0001", "pkg-a", "1.2.3")))
+	assert.NotEqual(t, newMatch("CVE-2024-0001", "pkg-a", "1.2.4").Fingerprint(), Fingerprint(newModel("CVE-2024-0001", "pkg-a", "1.2.3")))
+}
+
+func TestBaseline(t *testing.T) {
+	b := New(models.Document{
+		Matches: []models.Match{
+			newModel("CVE-2024-0001", "pkg-a"),          // unchanged
+			newModel("CVE-2024-0002", "pkg-a"),          // changed: a fix is now available
+			newModel("CVE-2024-0003", "pkg-b"),          // resolved
+			newModel("CVE-2024-0004", "pkg-b", "2.0.0"), // now ignored by a rule
+		},
+	})
+
+	unchanged := newMatch("CVE-2024-0001", "pkg-a")
+	changed := newMatch("CVE-2024-0002", "pkg-a", "1.0.1")
+	added := newMatch("CVE-2024-0005", "pkg-c")
+	ignoredByRule := match.IgnoredMatch{
+		Match:              newMatch("CVE-2024-0004", "pkg-b", "2.0.0"),
+		AppliedIgnoreRules: []match.IgnoreRule{{Vulnerability: "CVE-2024-0004"}},
+	}
+
+	remaining, ignored := b.Apply(match.NewMatches(unchanged, changed, added), []match.IgnoredMatch{ignoredByRule})
+
+	assert.Equal(t, []match.Match{changed, added}, remaining.Sorted())
+	assert.Equal(t, []match.IgnoredMatch{
+		ignoredByRule,
+		{
+			Match:              unchanged,
+			AppliedIgnoreRules: []match.IgnoreRule{{Namespace: "baseline", Reason: "finding is present in the baseline"}},
+		},
+	}, ignored)
+
+	resolved := b.Resolved(remaining, ignored)
+	require.Len(t, resolved, 1)
+	assert.Equal(t, "CVE-2024-0003", resolved[0].Vulnerability.ID)
+}
+
+func TestBaseline_Nil(t *testing.T) {
+	var b *Baseline
+	matches := match.NewMatches(newMatch("CVE-2024-0001", "pkg-a"))
+
+	remaining, ignored := b.Apply(matches, nil)
+	assert.Equal(t, matches, remaining)
+	assert.Empty(t, ignored)
+	assert.Empty(t, b.Resolved(matches, nil))
+}
+
+func TestRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	contents, err := json.Marshal(models.Document{Matches: []models.Match{newModel("CVE-2024-0001", "pkg-a", "1.0.0")}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, contents, 0600))
+
+	b, err := Read(path)
+	require.NoError(t, err)
+
+	remaining, _ := b.Apply(match.NewMatches(newMatch("CVE-2024-0001", "pkg-a", "1.0.0")), nil)
+	assert.Empty(t, remaining.Sorted())
+
+	_, err = Read(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+
+	notJSON := filepath.Join(t.TempDir(), "report.txt")
+	require.NoError(t, os.WriteFile(notJSON, []byte("NAME INSTALLED"), 0600))
+	_, err = Read(notJSON)
+	assert.Error(t, err)
+}
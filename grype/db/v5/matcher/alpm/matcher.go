@@ -0,0 +1,61 @@
+package alpm
+
+import (
+	"fmt"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+type Matcher struct {
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.AlpmPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.AlpmMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	matches := make([]match.Match, 0)
+
+	// the Arch Linux security tracker reports vulnerabilities against the base package (pkgbase), which split
+	// packages (e.g. "libldap" from "openldap") are built from
+	baseMatches, err := m.matchUpstreamPackages(store, d, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to match by base package indirection: %w", err)
+	}
+	matches = append(matches, baseMatches...)
+
+	exactMatches, err := search.ByPackageDistro(store, d, p, m.Type())
+	if err != nil {
+		return nil, fmt.Errorf("failed to match by exact package name: %w", err)
+	}
+	matches = append(matches, exactMatches...)
+
+	return matches, nil
+}
+
+func (m *Matcher) matchUpstreamPackages(store v5.ProviderByDistro, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	var matches []match.Match
+
+	for _, indirectPackage := range pkg.UpstreamPackages(p) {
+		indirectMatches, err := search.ByPackageDistro(store, d, indirectPackage, m.Type())
+		if err != nil {
+			return nil, fmt.Errorf("failed to find vulnerabilities for alpm base package: %w", err)
+		}
+		matches = append(matches, indirectMatches...)
+	}
+
+	// we want to make certain that we are tracking the match based on the package from the SBOM (not the indirect package)
+	// however, we also want to keep the indirect package around for future reference
+	match.ConvertToIndirectMatches(matches, p)
+
+	return matches, nil
+}
@@ -0,0 +1,63 @@
+package alpm
+
+import (
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+type mockProvider struct {
+	data map[distro.Type]map[string][]vulnerability.Vulnerability
+}
+
+func newMockProvider() *mockProvider {
+	pr := mockProvider{
+		data: make(map[distro.Type]map[string][]vulnerability.Vulnerability),
+	}
+	pr.stub()
+	return &pr
+}
+
+func (pr *mockProvider) stub() {
+	pr.data[distro.ArchLinux] = map[string][]vulnerability.Vulnerability{
+		// direct...
+		"openssl": {
+			{
+				Constraint: version.MustGetConstraint("< 3.0.8-1", version.PacmanFormat),
+				Reference:  vulnerability.Reference{ID: "AVG-2023-fake-1", Namespace: "archlinux:distro:archlinux:rolling"},
+			},
+		},
+		// indirect (by pkgbase)...
+		"openldap": {
+			// expected...
+			{
+				Constraint: version.MustGetConstraint("< 2.6.4-1", version.PacmanFormat),
+				Reference:  vulnerability.Reference{ID: "AVG-2023-fake-2", Namespace: "archlinux:distro:archlinux:rolling"},
+			},
+			// unexpected...
+			{
+				Constraint: version.MustGetConstraint("< 2.4.0-1", version.PacmanFormat),
+				Reference:  vulnerability.Reference{ID: "AVG-2023-fake-BAD", Namespace: "archlinux:distro:archlinux:rolling"},
+			},
+		},
+	}
+}
+
+func (pr *mockProvider) GetByDistro(d *distro.Distro, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return pr.data[d.Type][p.Name], nil
+}
+
+func (pr *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (pr *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (pr *mockProvider) GetByLanguage(_ syftPkg.Language, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
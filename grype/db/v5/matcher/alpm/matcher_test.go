@@ -0,0 +1,88 @@
+package alpm
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcherAlpm(t *testing.T) {
+	d, err := distro.New(distro.ArchLinux, "", "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		p            pkg.Package
+		expected     []string
+		expectedType match.Type
+	}{
+		{
+			name: "direct match",
+			p: pkg.Package{
+				Name:    "openssl",
+				Version: "3.0.7-4",
+				Type:    syftPkg.AlpmPkg,
+			},
+			expected:     []string{"AVG-2023-fake-1"},
+			expectedType: match.ExactDirectMatch,
+		},
+		{
+			name: "fixed version (newer pkgrel of the same pkgver)",
+			p: pkg.Package{
+				Name:    "openssl",
+				Version: "3.0.8-2",
+				Type:    syftPkg.AlpmPkg,
+			},
+		},
+		{
+			name: "indirect match by base package",
+			p: pkg.Package{
+				Name:    "libldap",
+				Version: "2.6.3-1",
+				Type:    syftPkg.AlpmPkg,
+				Upstreams: []pkg.UpstreamPackage{
+					{Name: "openldap"},
+				},
+			},
+			expected:     []string{"AVG-2023-fake-2"},
+			expectedType: match.ExactIndirectMatch,
+		},
+		{
+			name: "epoch is respected",
+			p: pkg.Package{
+				Name:    "openssl",
+				Version: "1:1.0.0-1",
+				Type:    syftPkg.AlpmPkg,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := Matcher{}
+			actual, err := matcher.Match(newMockProvider(), d, test.p)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p.Name, m.Package.Name, "failed to capture original package name")
+				require.NotEmpty(t, m.Details)
+				for _, detail := range m.Details {
+					assert.Equal(t, test.expectedType, detail.Type)
+					assert.Equal(t, matcher.Type(), detail.Matcher)
+				}
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
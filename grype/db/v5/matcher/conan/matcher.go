@@ -0,0 +1,118 @@
+package conan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/internal/log"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+// upstreamCPESource is the source of the CPEs that the matcher derives from the upstream project of a conan package
+const upstreamCPESource cpe.Source = "conan-upstream"
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	// UseCPEs falls back to the CPEs of packages without a known upstream project (instead of reporting them as not
+	// evaluated)
+	UseCPEs bool
+}
+
+func NewConanMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.ConanPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.ConanMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	ref := newReference(p)
+
+	if strings.HasPrefix(strings.ToLower(ref.version), "cci.") {
+		// conan center versions such as "cci.20200101" are snapshots that have no corresponding upstream release
+		return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("conan package %q has a snapshot version with no upstream release", ref)}
+	}
+
+	projects, ok := upstreamProjects[strings.ToLower(ref.name)]
+	if !ok {
+		if m.cfg.UseCPEs {
+			return search.ByCriteria(store, d, p, m.Type(), search.ByCPE)
+		}
+		return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("no known upstream project for conan package %q", ref)}
+	}
+
+	log.WithFields("package", ref.String(), "projects", len(projects)).Trace("searching conan package by upstream project")
+
+	searchPkg := p
+	searchPkg.CPEs = nil
+	for _, project := range projects {
+		attributes := cpe.NewWithAny()
+		attributes.Part = "a"
+		attributes.Vendor = project.vendor
+		attributes.Product = project.product
+		attributes.Version = ref.version
+		searchPkg.CPEs = append(searchPkg.CPEs, cpe.CPE{Attributes: attributes, Source: upstreamCPESource})
+	}
+
+	matches, err := search.ByPackageCPE(store, d, searchPkg, m.Type())
+	for i := range matches {
+		matches[i].Package = p
+	}
+	return matches, err
+}
+
+// reference is a conan package reference ("name/version@user/channel"), where the user and channel are optional
+type reference struct {
+	name    string
+	version string
+	user    string
+	channel string
+}
+
+func newReference(p pkg.Package) reference {
+	ref := reference{
+		name:    p.Name,
+		version: p.Version,
+	}
+
+	if p.PURL == "" {
+		return ref
+	}
+
+	purl, err := packageurl.FromString(p.PURL)
+	if err != nil {
+		log.WithFields("purl", p.PURL, "error", err).Debug("unable to parse conan package URL")
+		return ref
+	}
+
+	// the user is the namespace of the package URL, while the channel is a qualifier
+	ref.user = purl.Namespace
+	ref.channel = purl.Qualifiers.Map()["channel"]
+	return ref
+}
+
+func (r reference) String() string {
+	s := r.name + "/" + r.version
+	if r.user != "" || r.channel != "" {
+		s += "@" + r.user + "/" + r.channel
+	}
+	return s
+}
@@ -0,0 +1,183 @@
+package conan
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name         string
+		p            pkg.Package
+		useCPEs      bool
+		expected     []string
+		notEvaluated bool
+	}{
+		{
+			name: "package is matched by the upstream project",
+			p: pkg.Package{
+				Name:    "zlib",
+				Version: "1.2.11",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/zlib@1.2.11",
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*", cpe.GeneratedSource), cpe.Must("cpe:2.3:a:madler:zlib:1.2.11:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			expected: []string{"CVE-2018-25032"},
+		},
+		{
+			name: "package with a user and channel is matched by the upstream project",
+			p: pkg.Package{
+				Name:    "zlib",
+				Version: "1.2.11",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/mycompany/zlib@1.2.11?channel=stable",
+			},
+			expected: []string{"CVE-2018-25032"},
+		},
+		{
+			name: "fixed version",
+			p: pkg.Package{
+				Name:    "zlib",
+				Version: "1.2.12",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/zlib@1.2.12",
+			},
+		},
+		{
+			name: "package with multiple upstream identities",
+			p: pkg.Package{
+				Name:    "libcurl",
+				Version: "7.85.0",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/libcurl@7.85.0",
+			},
+			expected: []string{"CVE-2022-43551", "CVE-2022-43552"},
+		},
+		{
+			name: "package without a known upstream project is not evaluated",
+			p: pkg.Package{
+				Name:    "somelib",
+				Version: "1.0.0",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/somelib@1.0.0",
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:somelib:somelib:1.0.0:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			notEvaluated: true,
+		},
+		{
+			name: "package without a known upstream project falls back to CPEs",
+			p: pkg.Package{
+				Name:    "somelib",
+				Version: "1.0.0",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/somelib@1.0.0",
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:somelib:somelib:1.0.0:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			useCPEs:  true,
+			expected: []string{"CVE-2024-0001"},
+		},
+		{
+			name: "conan center snapshot version is not evaluated",
+			p: pkg.Package{
+				Name:    "zlib",
+				Version: "cci.20200101",
+				Type:    syftPkg.ConanPkg,
+				PURL:    "pkg:conan/zlib@cci.20200101",
+			},
+			notEvaluated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := NewConanMatcher(MatcherConfig{UseCPEs: test.useCPEs})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			if test.notEvaluated {
+				var notEvaluated match.NotEvaluatedError
+				require.True(t, errors.As(err, &notEvaluated), "expected a not evaluated error, got %v", err)
+				assert.Empty(t, actual)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p, m.Package, "matches should reference the original package")
+			}
+			assert.ElementsMatch(t, test.expected, ids)
+		})
+	}
+}
+
+func TestReference(t *testing.T) {
+	tests := []struct {
+		purl     string
+		expected string
+	}{
+		{purl: "pkg:conan/zlib@1.2.11", expected: "zlib/1.2.11"},
+		{purl: "pkg:conan/mycompany/zlib@1.2.11?channel=stable", expected: "zlib/1.2.11@mycompany/stable"},
+		{purl: "not a purl", expected: "zlib/1.2.11"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.purl, func(t *testing.T) {
+			ref := newReference(pkg.Package{Name: "zlib", Version: "1.2.11", PURL: test.purl})
+			assert.Equal(t, test.expected, ref.String())
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	vuln := func(id, constraint string) vulnerability.Vulnerability {
+		return vulnerability.Vulnerability{
+			Constraint: version.MustGetConstraint(constraint, version.UnknownFormat),
+			Reference:  vulnerability.Reference{ID: id, Namespace: "nvd:cpe"},
+		}
+	}
+
+	return &mockProvider{
+		data: map[string][]vulnerability.Vulnerability{
+			"cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*":       {vuln("CVE-2018-25032", "< 1.2.12")},
+			"cpe:2.3:a:madler:zlib:*:*:*:*:*:*:*:*":     {vuln("CVE-2000-0000", "< 2.0.0")},
+			"cpe:2.3:a:haxx:libcurl:*:*:*:*:*:*:*:*":    {vuln("CVE-2022-43551", "< 7.87.0")},
+			"cpe:2.3:a:haxx:curl:*:*:*:*:*:*:*:*":       {vuln("CVE-2022-43552", "< 7.87.0")},
+			"cpe:2.3:a:somelib:somelib:*:*:*:*:*:*:*:*": {vuln("CVE-2024-0001", "< 1.0.1")},
+		},
+	}
+}
+
+type mockProvider struct {
+	data map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(c cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	c.Attributes.Version = ""
+	return mp.data[c.Attributes.BindToFmtString()], nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(_ syftPkg.Language, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
@@ -0,0 +1,62 @@
+package conan
+
+// upstreamProject identifies the upstream project a conan package is built from, by the vendor and product used for
+// the project in NVD CPEs
+type upstreamProject struct {
+	vendor  string
+	product string
+}
+
+// upstreamProjects maps conan package names (from conan center) to the upstream projects the packages are built from.
+// Conan package names are frequently generic (e.g. "zlib" or "expat"), so generated CPEs either miss the NVD records for
+// the project or match unrelated projects with the same name; these are the identities NVD uses for each project.
+var upstreamProjects = map[string][]upstreamProject{
+	"abseil":        {{"abseil", "abseil-cpp"}},
+	"boost":         {{"boost", "boost"}},
+	"brotli":        {{"google", "brotli"}},
+	"bzip2":         {{"bzip", "bzip2"}},
+	"c-ares":        {{"c-ares_project", "c-ares"}},
+	"cjson":         {{"cjson_project", "cjson"}},
+	"expat":         {{"libexpat_project", "libexpat"}},
+	"ffmpeg":        {{"ffmpeg", "ffmpeg"}},
+	"freetype":      {{"freetype", "freetype"}},
+	"giflib":        {{"giflib_project", "giflib"}},
+	"glib":          {{"gnome", "glib"}},
+	"gnutls":        {{"gnu", "gnutls"}},
+	"grpc":          {{"grpc", "grpc"}},
+	"harfbuzz":      {{"harfbuzz_project", "harfbuzz"}},
+	"jasper":        {{"jasper_project", "jasper"}},
+	"krb5":          {{"mit", "kerberos_5"}},
+	"libarchive":    {{"libarchive", "libarchive"}},
+	"libcurl":       {{"haxx", "libcurl"}, {"haxx", "curl"}},
+	"libevent":      {{"libevent_project", "libevent"}},
+	"libgcrypt":     {{"gnupg", "libgcrypt"}},
+	"libiconv":      {{"gnu", "libiconv"}},
+	"libjpeg":       {{"ijg", "libjpeg"}},
+	"libjpeg-turbo": {{"libjpeg-turbo", "libjpeg-turbo"}},
+	"libpng":        {{"libpng", "libpng"}},
+	"libsodium":     {{"libsodium_project", "libsodium"}},
+	"libssh2":       {{"libssh2", "libssh2"}},
+	"libtiff":       {{"libtiff", "libtiff"}},
+	"libuv":         {{"libuv", "libuv"}},
+	"libwebp":       {{"webmproject", "libwebp"}},
+	"libxml2":       {{"xmlsoft", "libxml2"}},
+	"libxslt":       {{"xmlsoft", "libxslt"}},
+	"libyaml":       {{"pyyaml", "libyaml"}},
+	"lz4":           {{"lz4_project", "lz4"}},
+	"mbedtls":       {{"arm", "mbed_tls"}},
+	"nghttp2":       {{"nghttp2", "nghttp2"}},
+	"openjpeg":      {{"uclouvain", "openjpeg"}},
+	"openssl":       {{"openssl", "openssl"}},
+	"pcre":          {{"pcre", "pcre"}},
+	"pcre2":         {{"pcre", "pcre2"}},
+	"poco":          {{"pocoproject", "poco"}},
+	"protobuf":      {{"google", "protobuf"}},
+	"rapidjson":     {{"tencent", "rapidjson"}},
+	"sqlite3":       {{"sqlite", "sqlite"}},
+	"wolfssl":       {{"wolfssl", "wolfssl"}},
+	"xz_utils":      {{"tukaani", "xz"}},
+	"yaml-cpp":      {{"yaml-cpp_project", "yaml-cpp"}},
+	"zlib":          {{"zlib", "zlib"}},
+	"zstd":          {{"facebook", "zstandard"}},
+}
@@ -0,0 +1,141 @@
+package dart
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "vulnerable version",
+			p: pkg.Package{
+				Name:     "http",
+				Version:  "0.13.2",
+				Type:     syftPkg.DartPubPkg,
+				Language: syftPkg.Dart,
+			},
+			expected: []string{"GHSA-http"},
+		},
+		{
+			name: "fixed version",
+			p: pkg.Package{
+				Name:     "http",
+				Version:  "0.13.3",
+				Type:     syftPkg.DartPubPkg,
+				Language: syftPkg.Dart,
+			},
+		},
+		{
+			name: "build metadata is ordered before the fixed build",
+			p: pkg.Package{
+				Name:     "flutter_secure_storage",
+				Version:  "5.0.0+1",
+				Type:     syftPkg.DartPubPkg,
+				Language: syftPkg.Dart,
+			},
+			expected: []string{"GHSA-flutter-secure-storage"},
+		},
+		{
+			name: "fixed build",
+			p: pkg.Package{
+				Name:     "flutter_secure_storage",
+				Version:  "5.0.0+2",
+				Type:     syftPkg.DartPubPkg,
+				Language: syftPkg.Dart,
+			},
+		},
+		{
+			name: "pre-release within the affected range",
+			p: pkg.Package{
+				Name:     "flutter_secure_storage",
+				Version:  "5.0.0-beta.3",
+				Type:     syftPkg.DartPubPkg,
+				Language: syftPkg.Dart,
+			},
+			expected: []string{"GHSA-flutter-secure-storage"},
+		},
+		{
+			name: "package without vulnerabilities",
+			p: pkg.Package{
+				Name:     "path",
+				Version:  "1.8.0",
+				Type:     syftPkg.DartPubPkg,
+				Language: syftPkg.Dart,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := NewDartPubMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p, m.Package, "matches should reference the original package")
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{
+		data: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			syftPkg.Dart: {
+				"http": {
+					{
+						Constraint: version.MustGetConstraint("< 0.13.3", version.PubFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-http", Namespace: "github:language:dart"},
+					},
+				},
+				"flutter_secure_storage": {
+					{
+						// unlike semver, pub orders versions by build metadata
+						Constraint: version.MustGetConstraint(">= 5.0.0-beta.1, < 5.0.0+2", version.PubFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-flutter-secure-storage", Namespace: "github:language:dart"},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	data map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.data[l][p.Name], nil
+}
@@ -0,0 +1,109 @@
+package githubactions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+// actionsLanguage is the language of the namespace that GitHub advisories for the "GitHub Actions" ecosystem are stored in
+// (e.g. "github:language:github-action").
+const actionsLanguage syftPkg.Language = "github-action"
+
+var (
+	// actions may only be pinned by full-length commit SHAs
+	commitSHAPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+	// tags such as "v3", "v3.5" or "v3.5.2" (floating major and minor tags are common for actions)
+	tagPattern = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+))?(?:\.(\d+))?(-[0-9A-Za-z.-]+)?$`)
+)
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	UseCPEs bool
+}
+
+func NewGithubActionsMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.GithubActionPkg, syftPkg.GithubActionWorkflowPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.GithubActionsMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	repo := repository(p.Name)
+	if repo == "" || p.Version == "" {
+		// local actions and workflows (e.g. "./.github/actions/build") are not published, so have no advisories
+		return nil, nil
+	}
+
+	if commitSHAPattern.MatchString(p.Version) {
+		return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("%s is pinned to commit %s, the version is unknown", p.Name, p.Version)}
+	}
+
+	ver, floating, ok := tagVersion(p.Version)
+	if !ok {
+		return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("%s is referenced by %q, which is not a version tag", p.Name, p.Version)}
+	}
+	if floating {
+		return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("%s is referenced by the floating tag %q, the release it points to is unknown", p.Name, p.Version)}
+	}
+
+	criteria := search.CommonCriteria
+	if m.cfg.UseCPEs {
+		criteria = append(criteria, search.ByCPE)
+	}
+
+	// advisories are published for the repository of the action (e.g. "github/codeql-action" for
+	// "github/codeql-action/init@v3")
+	searchPkg := p
+	searchPkg.Name = repo
+	searchPkg.Version = ver
+	searchPkg.Language = actionsLanguage
+
+	matches, err := search.ByCriteria(store, d, searchPkg, m.Type(), criteria...)
+	for i := range matches {
+		matches[i].Package = p
+	}
+	return matches, err
+}
+
+// repository returns the "owner/repo" the given action or reusable workflow is published from (or an empty string for
+// local references)
+func repository(name string) string {
+	fields := strings.Split(name, "/")
+	if len(fields) < 2 || fields[0] == "" || strings.HasPrefix(fields[0], ".") {
+		return ""
+	}
+	return fields[0] + "/" + fields[1]
+}
+
+// tagVersion returns the version of a tag ref and whether the tag is a floating major or minor tag (e.g. "v3" or
+// "v3.5"), which is moved to each new release within the series so the release it points to is unknown.
+func tagVersion(ref string) (string, bool, bool) {
+	m := tagPattern.FindStringSubmatch(ref)
+	if m == nil {
+		return "", false, false
+	}
+
+	if m[2] == "" || m[3] == "" {
+		return "", true, true
+	}
+	return strings.Join(m[1:4], ".") + m[4], false, true
+}
@@ -0,0 +1,195 @@
+package githubactions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name             string
+		p                pkg.Package
+		expected         []string
+		wantNotEvaluated bool
+	}{
+		{
+			name: "vulnerable semver tag",
+			p: pkg.Package{
+				Name:    "tj-actions/changed-files",
+				Version: "v35.7.6",
+				Type:    syftPkg.GithubActionPkg,
+			},
+			expected: []string{"GHSA-changed-files"},
+		},
+		{
+			name: "fixed semver tag",
+			p: pkg.Package{
+				Name:    "tj-actions/changed-files",
+				Version: "v35.7.7",
+				Type:    syftPkg.GithubActionPkg,
+			},
+		},
+		{
+			name: "floating major tag",
+			p: pkg.Package{
+				Name:    "tj-actions/changed-files",
+				Version: "v35",
+				Type:    syftPkg.GithubActionPkg,
+			},
+			wantNotEvaluated: true,
+		},
+		{
+			name: "floating minor tag",
+			p: pkg.Package{
+				Name:    "tj-actions/changed-files",
+				Version: "v35.7",
+				Type:    syftPkg.GithubActionPkg,
+			},
+			wantNotEvaluated: true,
+		},
+		{
+			name: "action within a repository",
+			p: pkg.Package{
+				Name:    "github/codeql-action/init",
+				Version: "v2.1.0",
+				Type:    syftPkg.GithubActionPkg,
+			},
+			expected: []string{"GHSA-codeql-action"},
+		},
+		{
+			name: "reusable workflow",
+			p: pkg.Package{
+				Name:    "github/codeql-action/.github/workflows/analyze.yml",
+				Version: "v2.1.0",
+				Type:    syftPkg.GithubActionWorkflowPkg,
+			},
+			expected: []string{"GHSA-codeql-action"},
+		},
+		{
+			name: "pinned commit SHA",
+			p: pkg.Package{
+				Name:    "tj-actions/changed-files",
+				Version: "2d756ea4c53f7f6b397767d8723b3a10a9f35bf2",
+				Type:    syftPkg.GithubActionPkg,
+			},
+			wantNotEvaluated: true,
+		},
+		{
+			name: "branch ref",
+			p: pkg.Package{
+				Name:    "tj-actions/changed-files",
+				Version: "main",
+				Type:    syftPkg.GithubActionPkg,
+			},
+			wantNotEvaluated: true,
+		},
+		{
+			name: "local action",
+			p: pkg.Package{
+				Name: "./.github/actions/build",
+				Type: syftPkg.GithubActionPkg,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := NewGithubActionsMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			if test.wantNotEvaluated {
+				var notEvaluated match.NotEvaluatedError
+				require.True(t, errors.As(err, &notEvaluated), "expected a not evaluated error, got %v", err)
+				assert.Empty(t, actual)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p, m.Package, "matches should reference the original package")
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func TestTagVersion(t *testing.T) {
+	tests := []struct {
+		ref      string
+		expected string
+		floating bool
+		ok       bool
+	}{
+		{ref: "v3", floating: true, ok: true},
+		{ref: "v3.5", floating: true, ok: true},
+		{ref: "v3.5.2", expected: "3.5.2", ok: true},
+		{ref: "1.0.0-beta.1", expected: "1.0.0-beta.1", ok: true},
+		{ref: "main"},
+		{ref: "releases/v2"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ref, func(t *testing.T) {
+			actual, floating, ok := tagVersion(test.ref)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.floating, floating)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{
+		data: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			actionsLanguage: {
+				"tj-actions/changed-files": {
+					{
+						Constraint: version.MustGetConstraint("< 35.7.7", version.UnknownFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-changed-files", Namespace: "github:language:github-action"},
+					},
+				},
+				"github/codeql-action": {
+					{
+						Constraint: version.MustGetConstraint(">= 2.0.0, < 2.3.0", version.UnknownFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-codeql-action", Namespace: "github:language:github-action"},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	data map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.data[l][p.Name], nil
+}
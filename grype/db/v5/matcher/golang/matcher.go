@@ -8,6 +8,8 @@ import (
 	"github.com/anchore/grype/grype/distro"
 	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/pkg/qualifier/goimports"
+	"github.com/anchore/grype/grype/vulnerability"
 	syftPkg "github.com/anchore/syft/syft/pkg"
 )
 
@@ -66,7 +68,51 @@ func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.
 		criteria = append(criteria, search.ByCPE)
 	}
 
-	return search.ByCriteria(store, d, p, m.Type(), criteria...)
+	matches, err := search.ByCriteria(store, d, p, m.Type(), criteria...)
+	if err != nil {
+		return nil, err
+	}
+
+	if metadata, ok := p.Metadata.(pkg.GolangBinMetadata); ok {
+		for i := range matches {
+			reachability := reachabilityOf(matches[i].Vulnerability, metadata.Symbols)
+			for j := range matches[i].Details {
+				matches[i].Details[j].Reachability = reachability
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// reachabilityOf determines if any of the symbols affected by the vulnerability are linked into the binary. Functions
+// that were inlined into all callers are not in the function table, so when an affected package has code in the binary
+// but none of the affected symbols are found the reachability is unknown; the vulnerability is only unreachable when
+// none of the affected packages have code in the binary.
+func reachabilityOf(vuln vulnerability.Vulnerability, symbols *pkg.GolangSymbols) match.Reachability {
+	imports, ok := goimports.FromQualifiers(vuln.PackageQualifiers)
+	if !ok || len(imports) == 0 || symbols == nil {
+		return match.ReachabilityUnknown
+	}
+
+	reachability := match.Unreachable
+	for _, i := range imports {
+		if !symbols.HasPackage(i.Path) {
+			continue
+		}
+		if len(i.Symbols) == 0 {
+			// all symbols of the package are affected
+			return match.Reachable
+		}
+		for _, symbol := range i.Symbols {
+			if symbols.Has(i.Path, symbol) {
+				return match.Reachable
+			}
+		}
+		// the affected symbols may have been inlined
+		reachability = match.ReachabilityUnknown
+	}
+	return reachability
 }
 
 func searchByCPE(name string, cfg MatcherConfig) bool {
@@ -6,9 +6,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/scylladb/go-set/strset"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/pkg/qualifier"
+	"github.com/anchore/grype/grype/pkg/qualifier/goimports"
 	"github.com/anchore/grype/grype/version"
 	"github.com/anchore/grype/grype/vulnerability"
 	"github.com/anchore/syft/syft/cpe"
@@ -195,6 +199,97 @@ func TestMatcher_SearchForStdlib(t *testing.T) {
 
 }
 
+func TestMatcher_Reachability(t *testing.T) {
+	binaryPkg := func(symbols *pkg.GolangSymbols) pkg.Package {
+		return pkg.Package{
+			ID:       pkg.ID(uuid.NewString()),
+			Name:     "golang.org/x/net",
+			Version:  "v0.12.0",
+			Type:     syftPkg.GoModulePkg,
+			Language: syftPkg.Go,
+			Metadata: pkg.GolangBinMetadata{Symbols: symbols},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected map[string]match.Reachability
+	}{
+		{
+			name: "affected symbols are compared with the symbols of the binary",
+			p:    binaryPkg(pkg.NewGolangSymbols("golang.org/x/net/html.Parse", "golang.org/x/net/http2.(*Framer).ReadFrame")),
+			expected: map[string]match.Reachability{
+				"GO-2023-1988": match.Reachable,
+				// the package is linked into the binary, so the affected symbols may have been inlined
+				"GO-2023-2102":  match.ReachabilityUnknown,
+				"GO-2023-1571":  match.Reachable,
+				"CVE-2023-fake": match.ReachabilityUnknown,
+			},
+		},
+		{
+			name: "affected package only has inlined functions",
+			p: binaryPkg(pkg.NewGolangSymbols("golang.org/x/net/idna.ToASCII").
+				AddSourceFiles("golang.org/x/net@v0.12.0/http2/server.go")),
+			expected: map[string]match.Reachability{
+				"GO-2023-1988":  match.Unreachable,
+				"GO-2023-2102":  match.ReachabilityUnknown,
+				"GO-2023-1571":  match.Reachable,
+				"CVE-2023-fake": match.ReachabilityUnknown,
+			},
+		},
+		{
+			name: "affected package is not linked into the binary",
+			p:    binaryPkg(pkg.NewGolangSymbols("golang.org/x/net/idna.ToASCII")),
+			expected: map[string]match.Reachability{
+				"GO-2023-1988":  match.Unreachable,
+				"GO-2023-2102":  match.Unreachable,
+				"GO-2023-1571":  match.Unreachable,
+				"CVE-2023-fake": match.ReachabilityUnknown,
+			},
+		},
+		{
+			name: "binary symbols could not be read",
+			p:    binaryPkg(nil),
+			expected: map[string]match.Reachability{
+				"GO-2023-1988":  match.ReachabilityUnknown,
+				"GO-2023-2102":  match.ReachabilityUnknown,
+				"GO-2023-1571":  match.ReachabilityUnknown,
+				"CVE-2023-fake": match.ReachabilityUnknown,
+			},
+		},
+		{
+			name: "reachability is not determined for go.mod files",
+			p: func() pkg.Package {
+				p := binaryPkg(nil)
+				p.Metadata = pkg.GolangModMetadata{}
+				return p
+			}(),
+			expected: map[string]match.Reachability{
+				"GO-2023-1988":  "",
+				"GO-2023-2102":  "",
+				"GO-2023-1571":  "",
+				"CVE-2023-fake": "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matcher := NewGolangMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			require.NoError(t, err)
+
+			reachability := make(map[string]match.Reachability)
+			for _, m := range actual {
+				require.Len(t, m.Details, 1)
+				reachability[m.Vulnerability.ID] = m.Details[0].Reachability
+			}
+			assert.Equal(t, test.expected, reachability)
+		})
+	}
+}
+
 func newMockProvider() *mockProvider {
 	mp := mockProvider{
 		data: make(map[syftPkg.Language]map[string][]vulnerability.Vulnerability),
@@ -223,6 +318,29 @@ func (mp *mockProvider) populateData() {
 				Reference:  vulnerability.Reference{ID: "CVE-2013-fake-BAD"},
 			},
 		},
+		// for TestMatcher_Reachability
+		"golang.org/x/net": {
+			{
+				Constraint:        version.MustGetConstraint("< 0.13.0", version.GolangFormat),
+				Reference:         vulnerability.Reference{ID: "GO-2023-1988"},
+				PackageQualifiers: []qualifier.Qualifier{goimports.New([]goimports.Import{{Path: "golang.org/x/net/html", Symbols: []string{"Parse", "ParseFragment", "Tokenizer.Next"}}})},
+			},
+			{
+				Constraint:        version.MustGetConstraint("< 0.17.0", version.GolangFormat),
+				Reference:         vulnerability.Reference{ID: "GO-2023-2102"},
+				PackageQualifiers: []qualifier.Qualifier{goimports.New([]goimports.Import{{Path: "golang.org/x/net/http2", Symbols: []string{"Server.ServeConn", "serverConn.processHeaders"}}})},
+			},
+			{
+				// all symbols of the package are affected
+				Constraint:        version.MustGetConstraint("< 0.13.0", version.GolangFormat),
+				Reference:         vulnerability.Reference{ID: "GO-2023-1571"},
+				PackageQualifiers: []qualifier.Qualifier{goimports.New([]goimports.Import{{Path: "golang.org/x/net/http2"}})},
+			},
+			{
+				Constraint: version.MustGetConstraint("< 0.13.0", version.GolangFormat),
+				Reference:  vulnerability.Reference{ID: "CVE-2023-fake"},
+			},
+		},
 	}
 
 	mp.data["nvd:cpe"] = map[string][]vulnerability.Vulnerability{
@@ -0,0 +1,50 @@
+package hex
+
+import (
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	UseCPEs bool
+}
+
+func NewHexMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.HexPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.HexMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	criteria := search.CommonCriteria
+	if m.cfg.UseCPEs {
+		criteria = append(criteria, search.ByCPE)
+	}
+
+	// hex packages may be erlang (rebar) or elixir (mix) packages, however GitHub advisories for the hex ecosystem are
+	// all within the erlang language namespace
+	searchPkg := p
+	searchPkg.Language = syftPkg.Erlang
+
+	matches, err := search.ByCriteria(store, d, searchPkg, m.Type(), criteria...)
+	for i := range matches {
+		matches[i].Package = p
+	}
+	return matches, err
+}
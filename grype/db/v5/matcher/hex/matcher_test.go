@@ -0,0 +1,116 @@
+package hex
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "elixir package is searched in the erlang namespace",
+			p: pkg.Package{
+				Name:     "plug",
+				Version:  "1.3.4",
+				Type:     syftPkg.HexPkg,
+				Language: syftPkg.Elixir,
+			},
+			expected: []string{"GHSA-plug"},
+		},
+		{
+			name: "erlang package",
+			p: pkg.Package{
+				Name:     "plug",
+				Version:  "1.3.4",
+				Type:     syftPkg.HexPkg,
+				Language: syftPkg.Erlang,
+			},
+			expected: []string{"GHSA-plug"},
+		},
+		{
+			name: "fixed version",
+			p: pkg.Package{
+				Name:     "plug",
+				Version:  "1.3.5",
+				Type:     syftPkg.HexPkg,
+				Language: syftPkg.Elixir,
+			},
+		},
+		{
+			name: "pre-release of fixed version",
+			p: pkg.Package{
+				Name:     "plug",
+				Version:  "1.3.5-rc.0",
+				Type:     syftPkg.HexPkg,
+				Language: syftPkg.Elixir,
+			},
+			expected: []string{"GHSA-plug"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := NewHexMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p, m.Package, "matches should reference the original package")
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{
+		data: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			syftPkg.Erlang: {
+				"plug": {
+					{
+						Constraint: version.MustGetConstraint(">= 1.3.0, < 1.3.5", version.HexFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-plug", Namespace: "github:language:erlang"},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	data map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.data[l][p.Name], nil
+}
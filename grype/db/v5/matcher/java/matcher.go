@@ -2,8 +2,12 @@ package java
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"strings"
 
 	v5 "github.com/anchore/grype/grype/db/v5"
@@ -24,9 +28,35 @@ type Matcher struct {
 	cfg MatcherConfig
 }
 
+// maven SHA1 search backends
+const (
+	// MavenSearchBackend searches a maven central style (solr) search API, such as search.maven.org
+	MavenSearchBackend = "search"
+	// MavenIndexBackend looks up artifacts in a local index file (see MavenIndex)
+	MavenIndexBackend = "index"
+	// MavenNexusBackend searches a Nexus Repository 3 server
+	MavenNexusBackend = "nexus"
+)
+
+// MavenBackends are the supported values of ExternalSearchConfig.MavenBackend
+var MavenBackends = []string{MavenSearchBackend, MavenIndexBackend, MavenNexusBackend}
+
 type ExternalSearchConfig struct {
 	SearchMavenUpstream bool
 	MavenBaseURL        string
+	// MavenBackend selects how artifacts are looked up by SHA1, defaulting to MavenSearchBackend when empty
+	MavenBackend   string
+	MavenIndexFile string
+	MavenNexus     NexusConfig
+	// MavenCacheDir is where lookup results are kept between runs, caching is disabled when empty
+	MavenCacheDir string
+}
+
+type NexusConfig struct {
+	URL        string
+	Repository string
+	Username   string
+	Password   string
 }
 
 type MatcherConfig struct {
@@ -37,8 +67,37 @@ type MatcherConfig struct {
 func NewJavaMatcher(cfg MatcherConfig) *Matcher {
 	return &Matcher{
 		cfg:           cfg,
-		MavenSearcher: newMavenSearch(http.DefaultClient, cfg.MavenBaseURL),
+		MavenSearcher: newMavenSearcher(cfg.ExternalSearchConfig),
+	}
+}
+
+func newMavenSearcher(cfg ExternalSearchConfig) MavenSearcher {
+	var searcher MavenSearcher
+	switch cfg.MavenBackend {
+	case MavenIndexBackend:
+		searcher = newMavenIndexSearch(cfg.MavenIndexFile)
+	case MavenNexusBackend:
+		searcher = newNexusSearch(http.DefaultClient, cfg.MavenNexus)
+	default:
+		searcher = newMavenSearch(http.DefaultClient, cfg.MavenBaseURL)
+	}
+
+	// lookups in the local index are cheap, and the index may be rebuilt at any time, so these are not cached
+	if cfg.MavenCacheDir != "" && cfg.MavenBackend != MavenIndexBackend {
+		searcher = newCachedMavenSearch(searcher, mavenCacheDir(cfg))
+	}
+	return searcher
+}
+
+// mavenCacheDir returns the directory lookup results are cached in, which is specific to the backend and the server
+// (and repository) that is searched, since each may know of a different set of artifacts.
+func mavenCacheDir(cfg ExternalSearchConfig) string {
+	backend, location := MavenSearchBackend, cfg.MavenBaseURL
+	if cfg.MavenBackend == MavenNexusBackend {
+		backend, location = MavenNexusBackend, strings.TrimSuffix(cfg.MavenNexus.URL, "/")+"/"+cfg.MavenNexus.Repository
 	}
+	digest := sha256.Sum256([]byte(location))
+	return filepath.Join(cfg.MavenCacheDir, backend, hex.EncodeToString(digest[:8]))
 }
 
 func (m *Matcher) PackageTypes() []syftPkg.Type {
@@ -54,10 +113,11 @@ func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.
 	if m.cfg.SearchMavenUpstream {
 		upstreamMatches, err := m.matchUpstreamMavenPackages(store, d, p)
 		if err != nil {
-			if strings.Contains(err.Error(), "no artifact found") {
+			if errors.Is(err, errMavenArtifactNotFound) {
 				log.Debugf("no upstream maven artifact found for %s", p.Name)
+			} else {
+				log.WithFields("package", p.Name, "error", err).Warn("failed to resolve package details with maven")
 			}
-			log.WithFields("package", p.Name, "error", err).Warn("failed to resolve package details with maven")
 		} else {
 			matches = append(matches, upstreamMatches...)
 		}
@@ -0,0 +1,132 @@
+package java
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/internal/log"
+)
+
+// mavenCacheMissTTL is how long an artifact that could not be found is remembered for, since it may be published later
+// (the coordinates of an artifact that was found never change)
+const mavenCacheMissTTL = 24 * time.Hour
+
+// mavenCacheEntry is the on-disk record of a single lookup
+type mavenCacheEntry struct {
+	GroupID    string    `json:"groupId,omitempty"`
+	ArtifactID string    `json:"artifactId,omitempty"`
+	Version    string    `json:"version,omitempty"`
+	NotFound   bool      `json:"notFound,omitempty"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// cachedMavenSearch wraps a MavenSearcher, keeping the results of lookups in a directory so they can be reused between
+// runs. Failed lookups (other than the artifact not being found) are not cached.
+type cachedMavenSearch struct {
+	searcher MavenSearcher
+	dir      string
+	now      func() time.Time
+}
+
+func newCachedMavenSearch(searcher MavenSearcher, dir string) *cachedMavenSearch {
+	return &cachedMavenSearch{
+		searcher: searcher,
+		dir:      dir,
+		now:      time.Now,
+	}
+}
+
+func (c *cachedMavenSearch) GetMavenPackageBySha(ctx context.Context, sha1 string) (*pkg.Package, error) {
+	digest := strings.ToLower(sha1)
+	if !isSha1Digest(digest) {
+		// never use unexpected input as part of a path
+		return c.searcher.GetMavenPackageBySha(ctx, sha1)
+	}
+
+	if entry, ok := c.read(digest); ok {
+		if entry.NotFound {
+			return nil, fmt.Errorf("digest %s: %w", sha1, errMavenArtifactNotFound)
+		}
+		return newMavenPackage(entry.GroupID, entry.ArtifactID, entry.Version), nil
+	}
+
+	p, err := c.searcher.GetMavenPackageBySha(ctx, sha1)
+	switch {
+	case errors.Is(err, errMavenArtifactNotFound):
+		c.write(digest, mavenCacheEntry{NotFound: true})
+	case err != nil:
+		return nil, err
+	default:
+		if metadata, ok := p.Metadata.(pkg.JavaMetadata); ok {
+			c.write(digest, mavenCacheEntry{
+				GroupID:    metadata.PomGroupID,
+				ArtifactID: metadata.PomArtifactID,
+				Version:    p.Version,
+			})
+		}
+	}
+	return p, err
+}
+
+func (c *cachedMavenSearch) path(digest string) string {
+	return filepath.Join(c.dir, digest[:2], digest+".json")
+}
+
+func (c *cachedMavenSearch) read(digest string) (mavenCacheEntry, bool) {
+	var entry mavenCacheEntry
+	contents, err := os.ReadFile(c.path(digest))
+	if err != nil {
+		return entry, false
+	}
+	if err := json.Unmarshal(contents, &entry); err != nil {
+		log.WithFields("digest", digest, "error", err).Debug("ignoring invalid maven cache entry")
+		return entry, false
+	}
+	if entry.NotFound && c.now().Sub(entry.Timestamp) > mavenCacheMissTTL {
+		return entry, false
+	}
+	if !entry.NotFound && (entry.GroupID == "" || entry.ArtifactID == "") {
+		return entry, false
+	}
+	return entry, true
+}
+
+// write stores the entry, a failure to do so is not fatal since the lookup will be repeated on the next run
+func (c *cachedMavenSearch) write(digest string, entry mavenCacheEntry) {
+	entry.Timestamp = c.now()
+	contents, err := json.Marshal(entry)
+	if err != nil {
+		log.WithFields("digest", digest, "error", err).Debug("unable to encode maven cache entry")
+		return
+	}
+
+	path := c.path(digest)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		log.WithFields("dir", c.dir, "error", err).Debug("unable to create maven cache directory")
+		return
+	}
+	// matchers run concurrently, write the entry in full before making it visible to other lookups
+	f, err := os.CreateTemp(filepath.Dir(path), digest+".*.tmp")
+	if err != nil {
+		log.WithFields("path", path, "error", err).Debug("unable to write maven cache entry")
+		return
+	}
+	_, err = f.Write(contents)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		log.WithFields("path", path, "error", err).Debug("unable to write maven cache entry")
+	}
+}
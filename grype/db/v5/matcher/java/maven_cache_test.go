@@ -0,0 +1,90 @@
+package java
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/pkg"
+)
+
+type countingMavenSearcher struct {
+	results map[string]*pkg.Package
+	err     error
+	calls   int
+}
+
+func (s *countingMavenSearcher) GetMavenPackageBySha(_ context.Context, sha1 string) (*pkg.Package, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if p, ok := s.results[sha1]; ok {
+		return p, nil
+	}
+	return nil, fmt.Errorf("digest %s: %w", sha1, errMavenArtifactNotFound)
+}
+
+func TestCachedMavenSearch(t *testing.T) {
+	const (
+		known   = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+		unknown = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	)
+	dir := t.TempDir()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	upstream := &countingMavenSearcher{
+		results: map[string]*pkg.Package{
+			known: newMavenPackage("org.example", "lib", "1.0"),
+		},
+	}
+	newCache := func() *cachedMavenSearch {
+		c := newCachedMavenSearch(upstream, dir)
+		c.now = func() time.Time { return now }
+		return c
+	}
+
+	// the first run populates the cache...
+	cache := newCache()
+	p, err := cache.GetMavenPackageBySha(context.Background(), known)
+	require.NoError(t, err)
+	assert.Equal(t, newMavenPackage("org.example", "lib", "1.0"), p)
+	_, err = cache.GetMavenPackageBySha(context.Background(), unknown)
+	assert.ErrorIs(t, err, errMavenArtifactNotFound)
+	assert.Equal(t, 2, upstream.calls)
+
+	// ...which is used by the next run without searching again
+	upstream.err = errors.New("offline")
+	cache = newCache()
+	p, err = cache.GetMavenPackageBySha(context.Background(), known)
+	require.NoError(t, err)
+	assert.Equal(t, newMavenPackage("org.example", "lib", "1.0"), p)
+	_, err = cache.GetMavenPackageBySha(context.Background(), unknown)
+	assert.ErrorIs(t, err, errMavenArtifactNotFound)
+	assert.Equal(t, 2, upstream.calls)
+
+	// artifacts that were not found are searched for again once the entry expires, and errors are not cached
+	now = now.Add(mavenCacheMissTTL + time.Minute)
+	cache = newCache()
+	_, err = cache.GetMavenPackageBySha(context.Background(), unknown)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, errMavenArtifactNotFound)
+	assert.Equal(t, 3, upstream.calls)
+
+	upstream.err = nil
+	upstream.results[unknown] = newMavenPackage("org.example", "published-later", "2.0")
+	p, err = cache.GetMavenPackageBySha(context.Background(), unknown)
+	require.NoError(t, err)
+	assert.Equal(t, "org.example:published-later", p.Name)
+	assert.Equal(t, 4, upstream.calls)
+
+	// found artifacts never expire
+	_, err = cache.GetMavenPackageBySha(context.Background(), known)
+	require.NoError(t, err)
+	assert.Equal(t, 4, upstream.calls)
+}
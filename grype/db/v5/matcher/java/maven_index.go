@@ -0,0 +1,253 @@
+package java
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // sha1 is the digest maven repositories publish for artifacts
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/internal/log"
+)
+
+// mavenIndexArchiveExtensions are the artifact types included when building an index from a maven repository
+var mavenIndexArchiveExtensions = []string{".jar", ".war", ".ear", ".jpi", ".hpi"}
+
+// MavenCoordinates are the group, artifact and version of a maven artifact
+type MavenCoordinates struct {
+	GroupID    string
+	ArtifactID string
+	Version    string
+}
+
+func (c MavenCoordinates) String() string {
+	return fmt.Sprintf("%s:%s:%s", c.GroupID, c.ArtifactID, c.Version)
+}
+
+func parseMavenCoordinates(s string) (MavenCoordinates, error) {
+	fields := strings.Split(s, ":")
+	if len(fields) != 3 || fields[0] == "" || fields[1] == "" || fields[2] == "" {
+		return MavenCoordinates{}, fmt.Errorf("invalid maven coordinates %q (expected groupId:artifactId:version)", s)
+	}
+	return MavenCoordinates{GroupID: fields[0], ArtifactID: fields[1], Version: fields[2]}, nil
+}
+
+// MavenIndex maps the SHA1 digest of maven artifacts to their coordinates. Indexes are stored as text files where each
+// line is a lowercase hex SHA1 digest followed by whitespace and the groupId:artifactId:version of the artifact (blank
+// lines and lines starting with '#' are ignored).
+type MavenIndex map[string]MavenCoordinates
+
+// ReadMavenIndex reads an index in the format written by MavenIndex.Write.
+func ReadMavenIndex(reader io.Reader) (MavenIndex, error) {
+	index := make(MavenIndex)
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid maven index entry on line %d: %q", lineNumber, line)
+		}
+		digest := strings.ToLower(fields[0])
+		if !isSha1Digest(digest) {
+			return nil, fmt.Errorf("invalid sha1 digest on line %d: %q", lineNumber, fields[0])
+		}
+		coordinates, err := parseMavenCoordinates(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		if existing, ok := index[digest]; ok && existing.String() < coordinates.String() {
+			// artifacts might have the same SHA-1 digests, keep the same (lowest) entry the maven search would
+			continue
+		}
+		index[digest] = coordinates
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read maven index: %w", err)
+	}
+	return index, nil
+}
+
+// Write writes the index sorted by digest.
+func (idx MavenIndex) Write(writer io.Writer) error {
+	digests := make([]string, 0, len(idx))
+	for digest := range idx {
+		digests = append(digests, digest)
+	}
+	sort.Strings(digests)
+
+	w := bufio.NewWriter(writer)
+	for _, digest := range digests {
+		if _, err := fmt.Fprintf(w, "%s %s\n", digest, idx[digest]); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// AddRepository adds every artifact found in a directory with the maven repository layout (such as ~/.m2/repository or
+// a repository exported from Nexus or Artifactory) to the index, returning the number of digests added. Published
+// ".sha1" checksum files are used when present, otherwise the digest is computed from the artifact.
+func (idx MavenIndex) AddRepository(root string) (int, error) {
+	count := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !hasMavenIndexArchiveExtension(path) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		coordinates, ok := mavenCoordinatesFromRepositoryPath(filepath.ToSlash(rel))
+		if !ok {
+			log.WithFields("path", path).Trace("skipping file outside of the maven repository layout")
+			return nil
+		}
+
+		digest, err := mavenArtifactSha1(path)
+		if err != nil {
+			return err
+		}
+
+		existing, ok := idx[digest]
+		switch {
+		case !ok:
+			count++
+		case existing.String() < coordinates.String():
+			return nil
+		}
+		idx[digest] = coordinates
+		return nil
+	})
+	return count, err
+}
+
+func hasMavenIndexArchiveExtension(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range mavenIndexArchiveExtensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
+
+// mavenCoordinatesFromRepositoryPath derives the coordinates of an artifact from its path within a maven repository,
+// which is <group path>/<artifactId>/<version>/<artifactId>-<version>[-<classifier>].<ext>
+func mavenCoordinatesFromRepositoryPath(rel string) (MavenCoordinates, bool) {
+	parts := strings.Split(rel, "/")
+	if len(parts) < 4 {
+		return MavenCoordinates{}, false
+	}
+	fileName := parts[len(parts)-1]
+	version := parts[len(parts)-2]
+	artifactID := parts[len(parts)-3]
+	groupID := strings.Join(parts[:len(parts)-3], ".")
+
+	prefix := artifactID + "-" + version
+	if strings.HasSuffix(version, "-SNAPSHOT") {
+		// snapshot artifacts may be timestamped (e.g. foo-1.0-20240101.120000-1.jar in the 1.0-SNAPSHOT directory)
+		prefix = artifactID + "-" + strings.TrimSuffix(version, "SNAPSHOT")
+	}
+	if !strings.HasPrefix(fileName, prefix) {
+		return MavenCoordinates{}, false
+	}
+
+	return MavenCoordinates{GroupID: groupID, ArtifactID: artifactID, Version: version}, true
+}
+
+func mavenArtifactSha1(path string) (string, error) {
+	if published, err := os.ReadFile(path + ".sha1"); err == nil {
+		// checksum files may include the file name after the digest
+		if fields := strings.Fields(string(published)); len(fields) > 0 && isSha1Digest(strings.ToLower(fields[0])) {
+			return strings.ToLower(fields[0]), nil
+		}
+		log.WithFields("path", path).Debug("ignoring invalid sha1 checksum file")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha1.New() //nolint:gosec // see import
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("unable to digest %s: %w", path, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func isSha1Digest(s string) bool {
+	if len(s) != hex.EncodedLen(sha1.Size) {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// mavenIndexSearch implements the MavenSearcher interface with a local index file, which is read on first use
+type mavenIndexSearch struct {
+	path  string
+	once  sync.Once
+	index MavenIndex
+	err   error
+}
+
+func newMavenIndexSearch(path string) *mavenIndexSearch {
+	return &mavenIndexSearch{
+		path: path,
+	}
+}
+
+func (mi *mavenIndexSearch) load() {
+	if mi.path == "" {
+		mi.err = errors.New("no maven index file configured")
+		return
+	}
+	f, err := os.Open(mi.path)
+	if err != nil {
+		mi.err = fmt.Errorf("unable to open maven index: %w", err)
+		return
+	}
+	defer f.Close()
+
+	mi.index, mi.err = ReadMavenIndex(f)
+	if mi.err == nil {
+		log.WithFields("path", mi.path, "artifacts", len(mi.index)).Debug("loaded maven index")
+	}
+}
+
+func (mi *mavenIndexSearch) GetMavenPackageBySha(_ context.Context, digest string) (*pkg.Package, error) {
+	if digest == "" {
+		return nil, errors.New("empty sha1 digest")
+	}
+
+	mi.once.Do(mi.load)
+	if mi.err != nil {
+		return nil, mi.err
+	}
+
+	coordinates, ok := mi.index[strings.ToLower(digest)]
+	if !ok {
+		return nil, fmt.Errorf("digest %s: %w", digest, errMavenArtifactNotFound)
+	}
+	return newMavenPackage(coordinates.GroupID, coordinates.ArtifactID, coordinates.Version), nil
+}
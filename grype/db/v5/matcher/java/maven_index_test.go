@@ -0,0 +1,165 @@
+package java
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/pkg"
+)
+
+func TestMavenCoordinatesFromRepositoryPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected MavenCoordinates
+		ok       bool
+	}{
+		{
+			path:     "org/apache/logging/log4j/log4j-core/2.14.1/log4j-core-2.14.1.jar",
+			expected: MavenCoordinates{GroupID: "org.apache.logging.log4j", ArtifactID: "log4j-core", Version: "2.14.1"},
+			ok:       true,
+		},
+		{
+			path:     "junit/junit/4.13/junit-4.13-sources.jar",
+			expected: MavenCoordinates{GroupID: "junit", ArtifactID: "junit", Version: "4.13"},
+			ok:       true,
+		},
+		{
+			path:     "com/example/app/1.0-SNAPSHOT/app-1.0-20240101.120000-1.war",
+			expected: MavenCoordinates{GroupID: "com.example", ArtifactID: "app", Version: "1.0-SNAPSHOT"},
+			ok:       true,
+		},
+		{
+			// file name does not match the artifact directory
+			path: "org/example/lib/1.0/other-1.0.jar",
+		},
+		{
+			// not deep enough to have a group
+			path: "lib/1.0/lib-1.0.jar",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			actual, ok := mavenCoordinatesFromRepositoryPath(test.path)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestMavenIndex_AddRepository(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, contents string) {
+		path := filepath.Join(root, filepath.FromSlash(rel))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	}
+
+	// the digest is computed when there is no checksum file...
+	write("org/example/lib/1.0/lib-1.0.jar", "lib")
+	// ...and the published checksum is used when there is one
+	write("org/example/app/2.0/app-2.0.war", "app")
+	write("org/example/app/2.0/app-2.0.war.sha1", "ABCDEFABCDEFABCDEFABCDEFABCDEFABCDEFABCD  app-2.0.war\n")
+	// ignored files
+	write("org/example/lib/1.0/lib-1.0.pom", "pom")
+	write("org/example/lib/1.0/unrelated.jar", "unrelated")
+
+	idx := make(MavenIndex)
+	count, err := idx.AddRepository(root)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	assert.Equal(t, MavenIndex{
+		// echo -n lib | sha1sum
+		"9d062bafff17ba8b9a1215c4c51485134d509d91": {GroupID: "org.example", ArtifactID: "lib", Version: "1.0"},
+		"abcdefabcdefabcdefabcdefabcdefabcdefabcd": {GroupID: "org.example", ArtifactID: "app", Version: "2.0"},
+	}, idx)
+}
+
+func TestMavenIndex_WriteRead(t *testing.T) {
+	idx := MavenIndex{
+		"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb": {GroupID: "org.example", ArtifactID: "b", Version: "2.0"},
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa": {GroupID: "org.example", ArtifactID: "a", Version: "1.0"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, idx.Write(&buf))
+	assert.Equal(t, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa org.example:a:1.0\n"+
+		"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb org.example:b:2.0\n", buf.String())
+
+	actual, err := ReadMavenIndex(strings.NewReader("# exported from nexus\n\n" + buf.String()))
+	require.NoError(t, err)
+	assert.Equal(t, idx, actual)
+}
+
+func TestReadMavenIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected MavenIndex
+		wantErr  require.ErrorAssertionFunc
+	}{
+		{
+			name: "duplicate digests keep the lowest coordinates",
+			input: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA jstl:jstl:1.2\n" +
+				"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa javax.servlet:jstl:1.2\n",
+			expected: MavenIndex{
+				"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa": {GroupID: "javax.servlet", ArtifactID: "jstl", Version: "1.2"},
+			},
+		},
+		{
+			name:    "invalid digest",
+			input:   "abc org.example:a:1.0\n",
+			wantErr: require.Error,
+		},
+		{
+			name:    "invalid coordinates",
+			input:   "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa org.example:a\n",
+			wantErr: require.Error,
+		},
+		{
+			name:    "too many fields",
+			input:   "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa org.example:a:1.0 extra\n",
+			wantErr: require.Error,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.wantErr == nil {
+				test.wantErr = require.NoError
+			}
+			actual, err := ReadMavenIndex(strings.NewReader(test.input))
+			test.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestMavenIndexSearch_GetMavenPackageBySha(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "maven-index.txt")
+	require.NoError(t, os.WriteFile(path, []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa org.springframework:spring-webmvc:5.1.5.RELEASE\n"), 0o600))
+
+	search := newMavenIndexSearch(path)
+
+	p, err := search.GetMavenPackageBySha(context.Background(), "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA")
+	require.NoError(t, err)
+	assert.Equal(t, "org.springframework:spring-webmvc", p.Name)
+	assert.Equal(t, "5.1.5.RELEASE", p.Version)
+	assert.Equal(t, pkg.JavaMetadata{PomGroupID: "org.springframework", PomArtifactID: "spring-webmvc"}, p.Metadata)
+
+	_, err = search.GetMavenPackageBySha(context.Background(), "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	assert.ErrorIs(t, err, errMavenArtifactNotFound)
+
+	_, err = newMavenIndexSearch(filepath.Join(t.TempDir(), "missing.txt")).GetMavenPackageBySha(context.Background(), "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, errMavenArtifactNotFound)
+}
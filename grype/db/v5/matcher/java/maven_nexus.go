@@ -0,0 +1,118 @@
+package java
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/anchore/grype/grype/pkg"
+)
+
+const nexusSearchPath = "/service/rest/v1/search"
+
+// nexusSearch implements the MavenSearcher interface with the search API of a Nexus Repository 3 server
+type nexusSearch struct {
+	client     *http.Client
+	baseURL    string
+	repository string
+	username   string
+	password   string
+}
+
+func newNexusSearch(client *http.Client, cfg NexusConfig) *nexusSearch {
+	return &nexusSearch{
+		client:     client,
+		baseURL:    strings.TrimSuffix(cfg.URL, "/"),
+		repository: cfg.Repository,
+		username:   cfg.Username,
+		password:   cfg.Password,
+	}
+}
+
+type nexusSearchResponse struct {
+	Items []struct {
+		Repository string `json:"repository"`
+		Format     string `json:"format"`
+		Group      string `json:"group"`
+		Name       string `json:"name"`
+		Version    string `json:"version"`
+	} `json:"items"`
+}
+
+func (ns *nexusSearch) GetMavenPackageBySha(ctx context.Context, sha1 string) (*pkg.Package, error) {
+	if sha1 == "" {
+		return nil, errors.New("empty sha1 digest")
+	}
+	if ns.baseURL == "" {
+		return nil, errors.New("empty nexus URL")
+	}
+	if ns.client == nil {
+		return nil, errors.New("HTTP client not initialized")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ns.baseURL+nexusSearchPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize HTTP client: %w", err)
+	}
+
+	q := req.URL.Query()
+	q.Set("sha1", sha1)
+	q.Set("format", "maven2")
+	if ns.repository != "" {
+		q.Set("repository", ns.repository)
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Accept", "application/json")
+	if ns.username != "" {
+		req.SetBasicAuth(ns.username, ns.password)
+	}
+
+	resp, err := ns.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sha1 search error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %s from %s", resp.Status, req.URL.String())
+	}
+
+	var res nexusSearchResponse
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("json decode error: %w", err)
+	}
+
+	items := res.Items[:0]
+	for _, item := range res.Items {
+		if item.Format != "" && item.Format != "maven2" {
+			continue
+		}
+		if item.Group == "" || item.Name == "" {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("digest %s: %w", sha1, errMavenArtifactNotFound)
+	}
+
+	// the same artifact may be found in several repositories, and artifacts might have the same SHA-1 digests
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+	d := items[0]
+
+	return newMavenPackage(d.Group, d.Name, d.Version), nil
+}
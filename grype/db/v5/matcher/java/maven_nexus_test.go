@@ -0,0 +1,63 @@
+package java
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/pkg"
+)
+
+func TestNexusSearch_GetMavenPackageBySha(t *testing.T) {
+	var query map[string][]string
+	var user, password string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, nexusSearchPath, r.URL.Path)
+		query = r.URL.Query()
+		user, password, _ = r.BasicAuth()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("sha1") {
+		case "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa":
+			_, _ = w.Write([]byte(`{"items": [
+				{"repository": "maven-releases", "format": "maven2", "group": "jstl", "name": "jstl", "version": "1.2"},
+				{"repository": "maven-central", "format": "maven2", "group": "javax.servlet", "name": "jstl", "version": "1.2"}
+			], "continuationToken": null}`))
+		case "cccccccccccccccccccccccccccccccccccccccc":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			_, _ = w.Write([]byte(`{"items": [], "continuationToken": null}`))
+		}
+	}))
+	defer ts.Close()
+
+	search := newNexusSearch(http.DefaultClient, NexusConfig{
+		URL:        ts.URL + "/",
+		Repository: "maven-public",
+		Username:   "user",
+		Password:   "secret",
+	})
+
+	p, err := search.GetMavenPackageBySha(context.Background(), "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	require.NoError(t, err)
+	assert.Equal(t, "javax.servlet:jstl", p.Name)
+	assert.Equal(t, "1.2", p.Version)
+	assert.Equal(t, pkg.JavaMetadata{PomGroupID: "javax.servlet", PomArtifactID: "jstl"}, p.Metadata)
+	assert.Equal(t, "maven-public", query["repository"][0])
+	assert.Equal(t, "maven2", query["format"][0])
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "secret", password)
+
+	_, err = search.GetMavenPackageBySha(context.Background(), "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	assert.ErrorIs(t, err, errMavenArtifactNotFound)
+
+	_, err = search.GetMavenPackageBySha(context.Background(), "cccccccccccccccccccccccccccccccccccccccc")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, errMavenArtifactNotFound)
+
+	_, err = newNexusSearch(http.DefaultClient, NexusConfig{}).GetMavenPackageBySha(context.Background(), "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	assert.Error(t, err)
+}
@@ -15,6 +15,9 @@ import (
 	syftPkg "github.com/anchore/syft/syft/pkg"
 )
 
+// errMavenArtifactNotFound is returned by searchers when no artifact is known for a digest
+var errMavenArtifactNotFound = errors.New("no artifact found")
+
 // MavenSearcher is the interface that wraps the GetMavenPackageBySha method.
 type MavenSearcher interface {
 	// GetMavenPackageBySha provides an interface for building a package from maven data based on a sha1 digest
@@ -99,7 +102,7 @@ func (ms *mavenSearch) GetMavenPackageBySha(ctx context.Context, sha1 string) (*
 	}
 
 	if len(res.Response.Docs) == 0 {
-		return nil, fmt.Errorf("digest %s: %w", sha1, errors.New("no artifact found"))
+		return nil, fmt.Errorf("digest %s: %w", sha1, errMavenArtifactNotFound)
 	}
 
 	// artifacts might have the same SHA-1 digests.
@@ -110,13 +113,18 @@ func (ms *mavenSearch) GetMavenPackageBySha(ctx context.Context, sha1 string) (*
 	})
 	d := docs[0]
 
+	return newMavenPackage(d.GroupID, d.ArtifactID, d.Version), nil
+}
+
+// newMavenPackage creates the package searched for vulnerabilities from the maven coordinates of an artifact
+func newMavenPackage(groupID, artifactID, version string) *pkg.Package {
 	return &pkg.Package{
-		Name:     fmt.Sprintf("%s:%s", d.GroupID, d.ArtifactID),
-		Version:  d.Version,
+		Name:     fmt.Sprintf("%s:%s", groupID, artifactID),
+		Version:  version,
 		Language: syftPkg.Java,
 		Metadata: pkg.JavaMetadata{
-			PomArtifactID: d.ArtifactID,
-			PomGroupID:    d.GroupID,
+			PomArtifactID: artifactID,
+			PomGroupID:    groupID,
 		},
-	}, nil
+	}
 }
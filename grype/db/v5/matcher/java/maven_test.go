@@ -4,6 +4,9 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -78,3 +81,91 @@ func TestNewMavenSearchRateLimiter(t *testing.T) {
 		}
 	})
 }
+
+func TestNewMavenSearcher(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		cfg      ExternalSearchConfig
+		expected MavenSearcher
+	}{
+		{
+			name:     "maven search by default",
+			cfg:      ExternalSearchConfig{MavenBaseURL: "https://search.maven.org/solrsearch/select"},
+			expected: &mavenSearch{},
+		},
+		{
+			name:     "index",
+			cfg:      ExternalSearchConfig{MavenBackend: MavenIndexBackend, MavenIndexFile: "index.txt"},
+			expected: &mavenIndexSearch{},
+		},
+		{
+			name:     "nexus",
+			cfg:      ExternalSearchConfig{MavenBackend: MavenNexusBackend, MavenNexus: NexusConfig{URL: "https://nexus.example.com"}},
+			expected: &nexusSearch{},
+		},
+		{
+			name:     "cached",
+			cfg:      ExternalSearchConfig{MavenBackend: MavenNexusBackend, MavenCacheDir: dir},
+			expected: &cachedMavenSearch{},
+		},
+		{
+			name:     "index is never cached",
+			cfg:      ExternalSearchConfig{MavenBackend: MavenIndexBackend, MavenIndexFile: "index.txt", MavenCacheDir: dir},
+			expected: &mavenIndexSearch{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := newMavenSearcher(test.cfg)
+			if reflect.TypeOf(actual) != reflect.TypeOf(test.expected) {
+				t.Fatalf("unexpected searcher: got %T, want %T", actual, test.expected)
+			}
+		})
+	}
+
+	cached := newMavenSearcher(ExternalSearchConfig{MavenBaseURL: "https://search.maven.org/solrsearch/select", MavenCacheDir: dir}).(*cachedMavenSearch)
+	if filepath.Dir(cached.dir) != filepath.Join(dir, MavenSearchBackend) {
+		t.Errorf("unexpected cache dir: %q", cached.dir)
+	}
+	if _, ok := cached.searcher.(*mavenSearch); !ok {
+		t.Errorf("unexpected cached searcher: %T", cached.searcher)
+	}
+}
+
+func Test_mavenCacheDir(t *testing.T) {
+	nexus := func(url, repository string) ExternalSearchConfig {
+		return ExternalSearchConfig{
+			MavenBackend:  MavenNexusBackend,
+			MavenNexus:    NexusConfig{URL: url, Repository: repository},
+			MavenCacheDir: "/cache",
+		}
+	}
+	search := func(url string) ExternalSearchConfig {
+		return ExternalSearchConfig{MavenBaseURL: url, MavenCacheDir: "/cache"}
+	}
+
+	// results are kept apart for each server and repository
+	dirs := map[string]ExternalSearchConfig{}
+	for _, cfg := range []ExternalSearchConfig{
+		search("https://search.maven.org/solrsearch/select"),
+		search("https://mirror.example.com/solrsearch/select"),
+		nexus("https://nexus.example.com", "maven-releases"),
+		nexus("https://nexus.example.com", "maven-snapshots"),
+		nexus("https://nexus.internal.example.com", "maven-releases"),
+	} {
+		dir := mavenCacheDir(cfg)
+		if other, ok := dirs[dir]; ok {
+			t.Errorf("cache dir %q is shared by %+v and %+v", dir, other, cfg)
+		}
+		dirs[dir] = cfg
+	}
+
+	if !strings.HasPrefix(mavenCacheDir(nexus("https://nexus.example.com", "maven-releases")), filepath.Join("/cache", MavenNexusBackend)) {
+		t.Errorf("nexus results should be cached under the nexus backend")
+	}
+	if mavenCacheDir(nexus("https://nexus.example.com/", "maven-releases")) != mavenCacheDir(nexus("https://nexus.example.com", "maven-releases")) {
+		t.Errorf("a trailing slash on the nexus URL should not change the cache dir")
+	}
+}
@@ -0,0 +1,203 @@
+package kernel
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/scylladb/go-set/strset"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/internal/log"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	// kernelLanguage is the language of the namespace that the affected ranges published by the kernel.org CNA are
+	// stored in (e.g. "kernel.org:language:linux-kernel")
+	kernelLanguage syftPkg.Language = "linux-kernel"
+	// kernelPackageName is the name the kernel.org CNA uses for the kernel in its affected ranges
+	kernelPackageName = "linux"
+)
+
+var branchPattern = regexp.MustCompile(`^[vV]?(\d+)\.(\d+)(?:\.(\d+))?`)
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	UseCPEs bool
+}
+
+func NewKernelMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.LinuxKernelPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.KernelMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	searchPkg := p
+	searchPkg.Name = kernelPackageName
+	searchPkg.Language = kernelLanguage
+
+	matches, err := search.ByCriteria(store, d, searchPkg, m.Type(), search.ByLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range matches {
+		matches[i].Package = p
+		reportStableFix(&matches[i], p.Version)
+	}
+
+	if m.cfg.UseCPEs {
+		cpeMatches, err := m.cpeMatches(store, d, p, searchPkg)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, cpeMatches...)
+	}
+
+	return matches, nil
+}
+
+// cpeMatches returns the CPE matches for vulnerabilities that the kernel.org CNA has not published affected ranges
+// for. The CNA records are authoritative, so CPE matches for the same vulnerabilities would either be duplicates or
+// (when the kernel version has the fix) false positives.
+func (m *Matcher) cpeMatches(store v5.VulnerabilityProvider, d *distro.Distro, p, searchPkg pkg.Package) ([]match.Match, error) {
+	cnaVulns, err := store.GetByLanguage(kernelLanguage, searchPkg)
+	if err != nil {
+		return nil, fmt.Errorf("matcher failed to fetch kernel.org records: %w", err)
+	}
+	covered := strset.New()
+	for _, v := range cnaVulns {
+		covered.Add(v.ID)
+	}
+
+	cpeMatches, err := search.ByCriteria(store, d, p, m.Type(), search.ByCPE)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []match.Match
+	for _, cm := range cpeMatches {
+		if covered.Has(cm.Vulnerability.ID) {
+			log.WithFields("vulnerability", cm.Vulnerability.ID, "package", p.Name).Trace("skipping CPE match for vulnerability with kernel.org affected ranges")
+			continue
+		}
+		matches = append(matches, cm)
+	}
+	return matches, nil
+}
+
+// reportStableFix narrows the fixes of the match to the release that fixes the vulnerability on the stable branch of
+// the given kernel version (e.g. "5.10.209" for "5.10.150"), or to the first release with the fix when the branch has no
+// fix (e.g. "6.8" for "6.7.12", since the 6.7 branch is no longer maintained).
+func reportStableFix(m *match.Match, rawVersion string) {
+	fixes := m.Vulnerability.Fix.Versions
+	if len(fixes) == 0 {
+		return
+	}
+
+	fix, err := stableFix(rawVersion, fixes)
+	if err != nil {
+		log.WithFields("version", rawVersion, "vulnerability", m.Vulnerability.ID, "error", err).Debug("unable to determine the stable kernel fix")
+		return
+	}
+	if fix == "" {
+		return
+	}
+
+	m.Vulnerability.Fix.Versions = []string{fix}
+	for i := range m.Details {
+		if found, ok := m.Details[i].Found.(map[string]interface{}); ok {
+			found["stableBranch"] = branch(rawVersion)
+			found["fixedIn"] = fix
+		}
+	}
+}
+
+// stableFix returns the lowest fix greater than the given version on the same stable branch, otherwise the lowest fix
+// greater than the given version on any branch.
+func stableFix(rawVersion string, fixes []string) (string, error) {
+	ver, err := version.NewVersion(rawVersion, version.KernelFormat)
+	if err != nil {
+		return "", err
+	}
+
+	var sameBranch, later []string
+	for _, fix := range fixes {
+		newer, err := isBefore(ver, fix)
+		if err != nil {
+			return "", err
+		}
+		if !newer {
+			continue
+		}
+		later = append(later, fix)
+		if branch(fix) == branch(rawVersion) {
+			sameBranch = append(sameBranch, fix)
+		}
+	}
+
+	if len(sameBranch) > 0 {
+		return lowest(sameBranch)
+	}
+	if len(later) > 0 {
+		return lowest(later)
+	}
+	return "", nil
+}
+
+func lowest(fixes []string) (string, error) {
+	result := fixes[0]
+	for _, fix := range fixes[1:] {
+		ver, err := version.NewVersion(fix, version.KernelFormat)
+		if err != nil {
+			return "", err
+		}
+		before, err := isBefore(ver, result)
+		if err != nil {
+			return "", err
+		}
+		if before {
+			result = fix
+		}
+	}
+	return result, nil
+}
+
+// isBefore indicates if the given version is lower than the other (raw) version
+func isBefore(ver *version.Version, other string) (bool, error) {
+	constraint, err := version.GetConstraint(fmt.Sprintf("< %s", other), version.KernelFormat)
+	if err != nil {
+		return false, err
+	}
+	return constraint.Satisfied(ver)
+}
+
+// branch returns the stable branch of the given kernel version (e.g. "5.10" for "5.10.209", or "2.6.32" for
+// "2.6.32.71", since 2.6 kernels had a stable branch per sublevel)
+func branch(rawVersion string) string {
+	m := branchPattern.FindStringSubmatch(rawVersion)
+	if m == nil {
+		return ""
+	}
+	if m[1] == "2" && m[2] == "6" && m[3] != "" {
+		return fmt.Sprintf("2.6.%s", m[3])
+	}
+	return fmt.Sprintf("%s.%s", m[1], m[2])
+}
@@ -0,0 +1,230 @@
+package kernel
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name        string
+		version     string
+		expectedIDs []string
+		// expectedFixes is the fix reported per vulnerability
+		expectedFixes map[string]string
+	}{
+		{
+			name:        "stable branch with a backported fix",
+			version:     "5.10.150",
+			expectedIDs: []string{"CVE-2024-26581"},
+			expectedFixes: map[string]string{
+				"CVE-2024-26581": "5.10.209",
+			},
+		},
+		{
+			name:        "local version suffix",
+			version:     "6.1.70-custom+",
+			expectedIDs: []string{"CVE-2024-26581", "CVE-2024-35950"},
+			expectedFixes: map[string]string{
+				"CVE-2024-26581": "6.1.78",
+				"CVE-2024-35950": "6.1.90",
+			},
+		},
+		{
+			name:        "stable branch without a backported fix",
+			version:     "6.7.5",
+			expectedIDs: []string{"CVE-2024-26581", "CVE-2024-35950"},
+			expectedFixes: map[string]string{
+				"CVE-2024-26581": "6.7.6",
+				"CVE-2024-35950": "6.8",
+			},
+		},
+		{
+			name:        "release candidate before the fix",
+			version:     "6.8-rc3",
+			expectedIDs: []string{"CVE-2024-26581", "CVE-2024-35950"},
+			expectedFixes: map[string]string{
+				"CVE-2024-26581": "6.8-rc4",
+				"CVE-2024-35950": "6.8",
+			},
+		},
+		{
+			name:        "release candidate with the fix",
+			version:     "6.8-rc5",
+			expectedIDs: []string{"CVE-2024-35950"},
+			expectedFixes: map[string]string{
+				"CVE-2024-35950": "6.8",
+			},
+		},
+		{
+			name:    "fixed release",
+			version: "6.1.90",
+		},
+		{
+			name:    "unaffected branch",
+			version: "5.4.250",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := pkg.Package{
+				ID:      pkg.ID(uuid.NewString()),
+				Name:    "linux-kernel",
+				Version: test.version,
+				Type:    syftPkg.LinuxKernelPkg,
+			}
+
+			matcher := NewKernelMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, p)
+			require.NoError(t, err)
+
+			var ids []string
+			fixes := make(map[string]string)
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, p, m.Package, "matches should reference the original package")
+				require.Len(t, m.Vulnerability.Fix.Versions, 1)
+				fixes[m.Vulnerability.ID] = m.Vulnerability.Fix.Versions[0]
+
+				require.Len(t, m.Details, 1)
+				found, ok := m.Details[0].Found.(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, m.Vulnerability.Fix.Versions[0], found["fixedIn"])
+				assert.Equal(t, branch(test.version), found["stableBranch"])
+			}
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+			if len(test.expectedFixes) > 0 {
+				assert.Equal(t, test.expectedFixes, fixes)
+			}
+		})
+	}
+}
+
+func TestMatcher_Match_CPEs(t *testing.T) {
+	nvdVuln := func(id string) vulnerability.Vulnerability {
+		return vulnerability.Vulnerability{
+			Constraint: version.MustGetConstraint("< 6.9", version.UnknownFormat),
+			Reference:  vulnerability.Reference{ID: id, Namespace: "nvd:cpe"},
+			CPEs:       []cpe.CPE{cpe.Must("cpe:2.3:o:linux:linux_kernel:*:*:*:*:*:*:*:*", "")},
+		}
+	}
+	store := newMockProvider()
+	// the first is covered by the kernel.org records (and fixed in this version), the second is not
+	store.cpeVulns = []vulnerability.Vulnerability{nvdVuln("CVE-2024-26581"), nvdVuln("CVE-2024-0001")}
+
+	p := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "linux-kernel",
+		Version: "6.7.7",
+		Type:    syftPkg.LinuxKernelPkg,
+		CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:o:linux:linux_kernel:6.7.7:*:*:*:*:*:*:*", "")},
+	}
+
+	tests := []struct {
+		name        string
+		useCPEs     bool
+		expectedIDs []string
+	}{
+		{
+			name:        "without CPE matching",
+			expectedIDs: []string{"CVE-2024-35950"},
+		},
+		{
+			name:        "CPE matches are only reported for vulnerabilities without kernel.org records",
+			useCPEs:     true,
+			expectedIDs: []string{"CVE-2024-35950", "CVE-2024-0001"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := NewKernelMatcher(MatcherConfig{UseCPEs: test.useCPEs}).Match(store, nil, p)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+			}
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+}
+
+func TestBranch(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{version: "6.1.78", expected: "6.1"},
+		{version: "6.8", expected: "6.8"},
+		{version: "6.8-rc4", expected: "6.8"},
+		{version: "v5.10.209-custom", expected: "5.10"},
+		{version: "2.6.32.71", expected: "2.6.32"},
+		{version: "3.0.101", expected: "3.0"},
+		{version: "not-a-version", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			assert.Equal(t, test.expected, branch(test.version))
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{
+		data: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			kernelLanguage: {
+				kernelPackageName: {
+					{
+						Constraint: version.MustGetConstraint(">= 5.10.150, < 5.10.209 || >= 5.15, < 5.15.149 || >= 6.1, < 6.1.78 || >= 6.7, < 6.7.6 || >= 6.8-rc1, < 6.8-rc4", version.KernelFormat),
+						Reference:  vulnerability.Reference{ID: "CVE-2024-26581", Namespace: "kernel.org:language:linux-kernel"},
+						Fix: vulnerability.Fix{
+							Versions: []string{"5.10.209", "5.15.149", "6.1.78", "6.7.6", "6.8-rc4"},
+							State:    vulnerability.FixStateFixed,
+						},
+					},
+					{
+						Constraint: version.MustGetConstraint(">= 6.1, < 6.1.90 || >= 6.6, < 6.8", version.KernelFormat),
+						Reference:  vulnerability.Reference{ID: "CVE-2024-35950", Namespace: "kernel.org:language:linux-kernel"},
+						Fix: vulnerability.Fix{
+							Versions: []string{"6.1.90", "6.6.30", "6.8"},
+							State:    vulnerability.FixStateFixed,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	data     map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+	cpeVulns []vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return mp.cpeVulns, nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.data[l][p.Name], nil
+}
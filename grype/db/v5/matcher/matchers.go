@@ -1,31 +1,48 @@
 package matcher
 
 import (
+	"github.com/anchore/grype/grype/db/v5/matcher/alpm"
 	"github.com/anchore/grype/grype/db/v5/matcher/apk"
+	"github.com/anchore/grype/grype/db/v5/matcher/conan"
+	"github.com/anchore/grype/grype/db/v5/matcher/dart"
 	"github.com/anchore/grype/grype/db/v5/matcher/dotnet"
 	"github.com/anchore/grype/grype/db/v5/matcher/dpkg"
+	"github.com/anchore/grype/grype/db/v5/matcher/githubactions"
 	"github.com/anchore/grype/grype/db/v5/matcher/golang"
+	"github.com/anchore/grype/grype/db/v5/matcher/hex"
 	"github.com/anchore/grype/grype/db/v5/matcher/java"
 	"github.com/anchore/grype/grype/db/v5/matcher/javascript"
+	"github.com/anchore/grype/grype/db/v5/matcher/kernel"
 	"github.com/anchore/grype/grype/db/v5/matcher/msrc"
+	"github.com/anchore/grype/grype/db/v5/matcher/nix"
+	"github.com/anchore/grype/grype/db/v5/matcher/php"
 	"github.com/anchore/grype/grype/db/v5/matcher/portage"
 	"github.com/anchore/grype/grype/db/v5/matcher/python"
 	"github.com/anchore/grype/grype/db/v5/matcher/rpm"
 	"github.com/anchore/grype/grype/db/v5/matcher/ruby"
 	"github.com/anchore/grype/grype/db/v5/matcher/rust"
 	"github.com/anchore/grype/grype/db/v5/matcher/stock"
+	"github.com/anchore/grype/grype/db/v5/matcher/swift"
 )
 
 // Config contains values used by individual matcher structs for advanced configuration
 type Config struct {
-	Java       java.MatcherConfig
-	Ruby       ruby.MatcherConfig
-	Python     python.MatcherConfig
-	Dotnet     dotnet.MatcherConfig
-	Javascript javascript.MatcherConfig
-	Golang     golang.MatcherConfig
-	Rust       rust.MatcherConfig
-	Stock      stock.MatcherConfig
+	Java          java.MatcherConfig
+	Ruby          ruby.MatcherConfig
+	Python        python.MatcherConfig
+	Dotnet        dotnet.MatcherConfig
+	Javascript    javascript.MatcherConfig
+	Golang        golang.MatcherConfig
+	Rust          rust.MatcherConfig
+	Php           php.MatcherConfig
+	Swift         swift.MatcherConfig
+	Dart          dart.MatcherConfig
+	Hex           hex.MatcherConfig
+	Conan         conan.MatcherConfig
+	GithubActions githubactions.MatcherConfig
+	Kernel        kernel.MatcherConfig
+	Nix           nix.MatcherConfig
+	Stock         stock.MatcherConfig
 }
 
 func NewDefaultMatchers(mc Config) []Matcher {
@@ -41,7 +58,16 @@ func NewDefaultMatchers(mc Config) []Matcher {
 		golang.NewGolangMatcher(mc.Golang),
 		&msrc.Matcher{},
 		&portage.Matcher{},
+		&alpm.Matcher{},
 		rust.NewRustMatcher(mc.Rust),
+		php.NewPhpComposerMatcher(mc.Php),
+		swift.NewSwiftMatcher(mc.Swift),
+		dart.NewDartPubMatcher(mc.Dart),
+		hex.NewHexMatcher(mc.Hex),
+		conan.NewConanMatcher(mc.Conan),
+		githubactions.NewGithubActionsMatcher(mc.GithubActions),
+		kernel.NewKernelMatcher(mc.Kernel),
+		nix.NewNixMatcher(mc.Nix),
 		stock.NewStockMatcher(mc.Stock),
 	}
 }
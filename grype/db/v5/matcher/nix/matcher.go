@@ -0,0 +1,224 @@
+package nix
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/internal/log"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+const (
+	// upstreamCPESource is the source of the CPEs that the matcher derives from the upstream project of a nix package
+	upstreamCPESource cpe.Source = "nix-upstream"
+
+	// nameCPESource is the source of the CPEs that the matcher derives from the name of a nix package without a known
+	// upstream project
+	nameCPESource cpe.Source = "nix-name"
+
+	storeDir = "/nix/store"
+)
+
+var (
+	// nixpkgs python packages are named after the interpreter they are built for (e.g. "python3.11-requests")
+	pythonPackagePattern = regexp.MustCompile(`^python3\.\d+-(.+)$`)
+	// snapshots of projects without a release (e.g. "unstable-2024-01-01" or "0-unstable-2024-01-01")
+	unstableVersionPattern = regexp.MustCompile(`(^|-)unstable-\d{4}-\d{2}-\d{2}$`)
+	// store path hashes are 32 characters of nix's base32 alphabet (which omits "e", "o", "u" and "t")
+	storeHashPattern = regexp.MustCompile(`^[0-9a-df-np-sv-z]{32}-`)
+)
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	// UseCPEs falls back to CPEs derived from the package name (without the store hash) for packages without a known
+	// upstream project (instead of reporting them as not evaluated)
+	UseCPEs bool
+}
+
+// CPEParameters are the CPE search parameters of a nix package match, along with the store path of the package so that
+// findings can be traced back to the closure the package is part of.
+type CPEParameters struct {
+	search.CPEParameters
+	StorePath string `json:"storePath,omitempty"`
+}
+
+func NewNixMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.NixPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.NixMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	if unstableVersionPattern.MatchString(p.Version) {
+		return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("nix package %q has a snapshot version with no upstream release", p.Name+"-"+p.Version)}
+	}
+
+	matches, err := m.matchUpstream(store, d, p)
+	if err != nil {
+		return nil, err
+	}
+
+	storePath := storePathOf(p)
+	for i := range matches {
+		matches[i].Package = p
+		if storePath != "" {
+			addStorePath(matches[i].Details, storePath)
+		}
+	}
+	return matches, nil
+}
+
+func (m *Matcher) matchUpstream(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	hash := storeHashOf(p)
+	name := stripStoreHash(p.Name, hash)
+
+	if groups := pythonPackagePattern.FindStringSubmatch(name); groups != nil {
+		// packages from the nixpkgs python package set are built from the python package of the same name
+		searchPkg := p
+		searchPkg.Name = groups[1]
+		searchPkg.Type = syftPkg.PythonPkg
+		searchPkg.Language = syftPkg.Python
+		return search.ByCriteria(store, d, searchPkg, m.Type(), search.ByLanguage)
+	}
+
+	searchPkg := p
+	searchPkg.Name = name
+	if projects, ok := upstreamProjects[strings.ToLower(name)]; ok {
+		// the upstream project overrides the CPEs generated from the package name
+		log.WithFields("package", name, "projects", len(projects)).Trace("searching nix package by upstream project")
+		searchPkg.CPEs = upstreamCPEs(projects, p.Version)
+	} else {
+		if !m.cfg.UseCPEs {
+			return nil, match.NotEvaluatedError{Reason: fmt.Sprintf("no known upstream project for nix package %q", name)}
+		}
+		searchPkg.CPEs = nameCPEs(p, name, hash)
+	}
+
+	return search.ByPackageCPE(store, d, searchPkg, m.Type())
+}
+
+// upstreamCPEs returns the CPEs to search for the given upstream projects of a package
+func upstreamCPEs(projects []upstreamProject, ver string) []cpe.CPE {
+	var cpes []cpe.CPE
+	for _, project := range projects {
+		attributes := cpe.NewWithAny()
+		attributes.Part = "a"
+		attributes.Vendor = project.vendor
+		attributes.Product = project.product
+		attributes.Version = ver
+		cpes = append(cpes, cpe.CPE{Attributes: attributes, Source: upstreamCPESource})
+	}
+	return cpes
+}
+
+// nameCPEs returns the CPEs of the package with the store hash removed from the vendor and product (CPEs generated
+// from a store path include the hash), or a CPE derived from the package name when the package has none.
+func nameCPEs(p pkg.Package, name, hash string) []cpe.CPE {
+	if len(p.CPEs) == 0 {
+		attributes := cpe.NewWithAny()
+		attributes.Part = "a"
+		attributes.Vendor = name
+		attributes.Product = name
+		attributes.Version = p.Version
+		return []cpe.CPE{{Attributes: attributes, Source: nameCPESource}}
+	}
+
+	cpes := make([]cpe.CPE, 0, len(p.CPEs))
+	for _, c := range p.CPEs {
+		c.Attributes.Vendor = stripStoreHash(c.Attributes.Vendor, hash)
+		c.Attributes.Product = stripStoreHash(c.Attributes.Product, hash)
+		cpes = append(cpes, c)
+	}
+	return cpes
+}
+
+// stripStoreHash removes the store path hash prefix (e.g. "<hash>-openssl") from the given value
+func stripStoreHash(value, hash string) string {
+	if hash != "" {
+		if trimmed, ok := strings.CutPrefix(value, hash+"-"); ok {
+			return trimmed
+		}
+	}
+	return storeHashPattern.ReplaceAllString(value, "")
+}
+
+// storeHashOf returns the hash of the store path the package was found at
+func storeHashOf(p pkg.Package) string {
+	storePath := storePathOf(p)
+	if storePath == "" {
+		return ""
+	}
+	hash, _, found := strings.Cut(path.Base(storePath), "-")
+	if !found {
+		return ""
+	}
+	return hash
+}
+
+// addStorePath records the store path of the package in the search parameters of the given match details
+func addStorePath(details []match.Detail, storePath string) {
+	for i := range details {
+		switch searchedBy := details[i].SearchedBy.(type) {
+		case search.CPEParameters:
+			details[i].SearchedBy = CPEParameters{
+				CPEParameters: searchedBy,
+				StorePath:     storePath,
+			}
+		case map[string]interface{}:
+			searchedBy["storePath"] = storePath
+		}
+	}
+}
+
+// storePathOf returns the nix store path the package was found at (e.g. "/nix/store/<hash>-openssl-3.0.13"), which is
+// either the location of the package or derived from the output hash in the package URL.
+func storePathOf(p pkg.Package) string {
+	for _, l := range p.Locations.ToSlice() {
+		if strings.HasSuffix(path.Dir(l.RealPath), storeDir) {
+			return l.RealPath
+		}
+	}
+
+	if p.PURL == "" {
+		return ""
+	}
+
+	purl, err := packageurl.FromString(p.PURL)
+	if err != nil {
+		log.WithFields("purl", p.PURL, "error", err).Debug("unable to parse nix package URL")
+		return ""
+	}
+
+	qualifiers := purl.Qualifiers.Map()
+	hash := qualifiers["outputhash"]
+	if hash == "" {
+		return ""
+	}
+
+	base := strings.Join([]string{hash, p.Name, p.Version}, "-")
+	if output := qualifiers["output"]; output != "" {
+		base += "-" + output
+	}
+	return path.Join(storeDir, base)
+}
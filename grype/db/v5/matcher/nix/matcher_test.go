@@ -0,0 +1,297 @@
+package nix
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	"github.com/anchore/syft/syft/file"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name         string
+		p            pkg.Package
+		useCPEs      bool
+		expected     []string
+		notEvaluated bool
+	}{
+		{
+			name: "package is matched by the upstream project",
+			p: pkg.Package{
+				Name:      "openssl",
+				Version:   "3.0.13",
+				Type:      syftPkg.NixPkg,
+				Locations: file.NewLocationSet(file.NewLocation("/nix/store/a1b2c3-openssl-3.0.13")),
+				CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:a1b2c3-openssl:a1b2c3-openssl:3.0.13:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			expected: []string{"CVE-2024-5535"},
+		},
+		{
+			name: "fixed version",
+			p: pkg.Package{
+				Name:    "openssl",
+				Version: "3.0.14",
+				Type:    syftPkg.NixPkg,
+			},
+		},
+		{
+			name: "package with a nixpkgs specific name",
+			p: pkg.Package{
+				Name:    "gnutar",
+				Version: "1.34",
+				Type:    syftPkg.NixPkg,
+			},
+			expected: []string{"CVE-2022-48303"},
+		},
+		{
+			name: "nix versions are compared",
+			p: pkg.Package{
+				Name:    "gnutar",
+				Version: "1.34pre1",
+				Type:    syftPkg.NixPkg,
+			},
+			expected: []string{"CVE-2022-48303"},
+		},
+		{
+			name: "package from the python package set",
+			p: pkg.Package{
+				Name:    "python3.11-requests",
+				Version: "2.31.0",
+				Type:    syftPkg.NixPkg,
+			},
+			expected: []string{"GHSA-9wx4-h78v-vm56"},
+		},
+		{
+			name: "package without a known upstream project is not evaluated",
+			p: pkg.Package{
+				Name:    "somelib",
+				Version: "1.0.0",
+				Type:    syftPkg.NixPkg,
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:somelib:somelib:1.0.0:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			notEvaluated: true,
+		},
+		{
+			name: "package without a known upstream project falls back to CPEs",
+			p: pkg.Package{
+				Name:    "somelib",
+				Version: "1.0.0",
+				Type:    syftPkg.NixPkg,
+				CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:somelib:somelib:1.0.0:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			useCPEs:  true,
+			expected: []string{"CVE-2024-0001"},
+		},
+		{
+			name: "CPE fallback without the store hash",
+			p: pkg.Package{
+				Name:      "somelib",
+				Version:   "1.0.0",
+				Type:      syftPkg.NixPkg,
+				Locations: file.NewLocationSet(file.NewLocation("/nix/store/a1b2c3-somelib-1.0.0")),
+				CPEs:      []cpe.CPE{cpe.Must("cpe:2.3:a:a1b2c3-somelib:a1b2c3-somelib:1.0.0:*:*:*:*:*:*:*", cpe.GeneratedSource)},
+			},
+			useCPEs:  true,
+			expected: []string{"CVE-2024-0001"},
+		},
+		{
+			name: "CPE fallback derived from a package name with a store hash",
+			p: pkg.Package{
+				Name:    "0c6jrmmzr0cqlcqgkqvw5gr2nl0k3cqf-somelib",
+				Version: "1.0.0",
+				Type:    syftPkg.NixPkg,
+			},
+			useCPEs:  true,
+			expected: []string{"CVE-2024-0001"},
+		},
+		{
+			name: "snapshot version is not evaluated",
+			p: pkg.Package{
+				Name:    "openssl",
+				Version: "0-unstable-2024-01-01",
+				Type:    syftPkg.NixPkg,
+			},
+			notEvaluated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := NewNixMatcher(MatcherConfig{UseCPEs: test.useCPEs})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			if test.notEvaluated {
+				var notEvaluated match.NotEvaluatedError
+				require.True(t, errors.As(err, &notEvaluated), "expected a not evaluated error, got %v", err)
+				assert.Empty(t, actual)
+				return
+			}
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p, m.Package, "matches should reference the original package")
+			}
+			assert.ElementsMatch(t, test.expected, ids)
+		})
+	}
+}
+
+func TestMatcher_Match_StorePath(t *testing.T) {
+	matcher := NewNixMatcher(MatcherConfig{})
+
+	p := pkg.Package{
+		ID:        pkg.ID(uuid.NewString()),
+		Name:      "openssl",
+		Version:   "3.0.13",
+		Type:      syftPkg.NixPkg,
+		Locations: file.NewLocationSet(file.NewLocation("/nix/store/a1b2c3-openssl-3.0.13")),
+	}
+	actual, err := matcher.Match(newMockProvider(), nil, p)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.Len(t, actual[0].Details, 1)
+
+	searchedBy, ok := actual[0].Details[0].SearchedBy.(CPEParameters)
+	require.True(t, ok)
+	assert.Equal(t, "/nix/store/a1b2c3-openssl-3.0.13", searchedBy.StorePath)
+	assert.Equal(t, []string{"cpe:2.3:a:openssl:openssl:3.0.13:*:*:*:*:*:*:*"}, searchedBy.CPEs)
+
+	p = pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "python3.11-requests",
+		Version: "2.31.0",
+		Type:    syftPkg.NixPkg,
+		PURL:    "pkg:nix/python3.11-requests@2.31.0?outputhash=d4e5f6",
+	}
+	actual, err = matcher.Match(newMockProvider(), nil, p)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.Len(t, actual[0].Details, 1)
+
+	params, ok := actual[0].Details[0].SearchedBy.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "/nix/store/d4e5f6-python3.11-requests-2.31.0", params["storePath"])
+}
+
+func TestStripStoreHash(t *testing.T) {
+	tests := []struct {
+		value    string
+		hash     string
+		expected string
+	}{
+		{value: "a1b2c3-openssl", hash: "a1b2c3", expected: "openssl"},
+		{value: "0c6jrmmzr0cqlcqgkqvw5gr2nl0k3cqf-openssl", expected: "openssl"},
+		// not a store hash (contains characters outside of the nix base32 alphabet)
+		{value: "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee-openssl", expected: "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee-openssl"},
+		{value: "c-ares", expected: "c-ares"},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			assert.Equal(t, test.expected, stripStoreHash(test.value, test.hash))
+		})
+	}
+}
+
+func TestStorePathOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected string
+	}{
+		{
+			name: "from the location",
+			p: pkg.Package{
+				Name:      "glibc",
+				Version:   "2.39-52",
+				Locations: file.NewLocationSet(file.NewLocation("/nix/store/a1b2c3-glibc-2.39-52-bin")),
+			},
+			expected: "/nix/store/a1b2c3-glibc-2.39-52-bin",
+		},
+		{
+			name: "from the package URL",
+			p: pkg.Package{
+				Name:    "glibc",
+				Version: "2.39-52",
+				PURL:    "pkg:nix/glibc@2.39-52?output=bin&outputhash=a1b2c3",
+			},
+			expected: "/nix/store/a1b2c3-glibc-2.39-52-bin",
+		},
+		{
+			name: "package URL without an output hash",
+			p: pkg.Package{
+				Name:    "glibc",
+				Version: "2.39-52",
+				PURL:    "pkg:nix/glibc@2.39-52",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, storePathOf(test.p))
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	vuln := func(id, constraint string) vulnerability.Vulnerability {
+		return vulnerability.Vulnerability{
+			Constraint: version.MustGetConstraint(constraint, version.UnknownFormat),
+			Reference:  vulnerability.Reference{ID: id, Namespace: "nvd:cpe"},
+		}
+	}
+
+	return &mockProvider{
+		cpes: map[string][]vulnerability.Vulnerability{
+			"cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*": {vuln("CVE-2024-5535", "< 3.0.14")},
+			"cpe:2.3:a:gnu:tar:*:*:*:*:*:*:*:*":         {vuln("CVE-2022-48303", "< 1.35")},
+			"cpe:2.3:a:somelib:somelib:*:*:*:*:*:*:*:*": {vuln("CVE-2024-0001", "< 1.0.1")},
+		},
+		languages: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			syftPkg.Python: {
+				"requests": {
+					{
+						Constraint: version.MustGetConstraint("< 2.32.0", version.PythonFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-9wx4-h78v-vm56", Namespace: "github:language:python"},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	cpes      map[string][]vulnerability.Vulnerability
+	languages map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(c cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	c.Attributes.Version = ""
+	return mp.cpes[c.Attributes.BindToFmtString()], nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.languages[l][p.Name], nil
+}
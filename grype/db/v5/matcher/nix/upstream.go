@@ -0,0 +1,79 @@
+package nix
+
+// upstreamProject identifies the upstream project a nixpkgs package is built from, by the vendor and product used for
+// the project in NVD CPEs
+type upstreamProject struct {
+	vendor  string
+	product string
+}
+
+// upstreamProjects maps nixpkgs package names (the "pname" in the store path) to the upstream projects the packages are
+// built from. Nixpkgs names frequently differ from the names of the projects (e.g. "gnutar" or "python3"), so generated
+// CPEs either miss the NVD records for the project or match unrelated projects; these are the identities NVD uses for
+// each project.
+var upstreamProjects = map[string][]upstreamProject{
+	"bash":          {{"gnu", "bash"}},
+	"binutils":      {{"gnu", "binutils"}},
+	"busybox":       {{"busybox", "busybox"}},
+	"bzip2":         {{"bzip", "bzip2"}},
+	"c-ares":        {{"c-ares_project", "c-ares"}},
+	"coreutils":     {{"gnu", "coreutils"}},
+	"curl":          {{"haxx", "curl"}, {"haxx", "libcurl"}},
+	"cyrus-sasl":    {{"cmu", "cyrus-sasl"}},
+	"dbus":          {{"freedesktop", "dbus"}},
+	"e2fsprogs":     {{"e2fsprogs_project", "e2fsprogs"}},
+	"expat":         {{"libexpat_project", "libexpat"}},
+	"freetype":      {{"freetype", "freetype"}},
+	"gawk":          {{"gnu", "gawk"}},
+	"gcc":           {{"gnu", "gcc"}},
+	"git":           {{"git-scm", "git"}},
+	"glib":          {{"gnome", "glib"}},
+	"glibc":         {{"gnu", "glibc"}},
+	"gnugrep":       {{"gnu", "grep"}},
+	"gnupg":         {{"gnupg", "gnupg"}},
+	"gnused":        {{"gnu", "sed"}},
+	"gnutar":        {{"gnu", "tar"}},
+	"gnutls":        {{"gnu", "gnutls"}},
+	"go":            {{"golang", "go"}},
+	"gzip":          {{"gnu", "gzip"}},
+	"harfbuzz":      {{"harfbuzz_project", "harfbuzz"}},
+	"krb5":          {{"mit", "kerberos_5"}},
+	"less":          {{"gnu", "less"}},
+	"libarchive":    {{"libarchive", "libarchive"}},
+	"libevent":      {{"libevent_project", "libevent"}},
+	"libffi":        {{"libffi_project", "libffi"}},
+	"libgcrypt":     {{"gnupg", "libgcrypt"}},
+	"libidn2":       {{"gnu", "libidn2"}},
+	"libjpeg-turbo": {{"libjpeg-turbo", "libjpeg-turbo"}},
+	"libpng":        {{"libpng", "libpng"}},
+	"libssh2":       {{"libssh2", "libssh2"}},
+	"libtiff":       {{"libtiff", "libtiff"}},
+	"libuv":         {{"libuv", "libuv"}},
+	"libwebp":       {{"webmproject", "libwebp"}},
+	"libxml2":       {{"xmlsoft", "libxml2"}},
+	"libxslt":       {{"xmlsoft", "libxslt"}},
+	"lz4":           {{"lz4_project", "lz4"}},
+	"ncurses":       {{"gnu", "ncurses"}},
+	"nghttp2":       {{"nghttp2", "nghttp2"}},
+	"nginx":         {{"f5", "nginx"}, {"nginx", "nginx"}},
+	"nodejs":        {{"nodejs", "node.js"}},
+	"openldap":      {{"openldap", "openldap"}},
+	"openssh":       {{"openbsd", "openssh"}},
+	"openssl":       {{"openssl", "openssl"}},
+	"pcre2":         {{"pcre", "pcre2"}},
+	"perl":          {{"perl", "perl"}},
+	"postgresql":    {{"postgresql", "postgresql"}},
+	"python3":       {{"python", "python"}},
+	"readline":      {{"gnu", "readline"}},
+	"redis":         {{"redis", "redis"}},
+	"ruby":          {{"ruby-lang", "ruby"}},
+	"sqlite":        {{"sqlite", "sqlite"}},
+	"sudo":          {{"sudo_project", "sudo"}},
+	"systemd":       {{"systemd_project", "systemd"}},
+	"util-linux":    {{"kernel", "util-linux"}},
+	"vim":           {{"vim", "vim"}},
+	"wget":          {{"gnu", "wget"}},
+	"xz":            {{"tukaani", "xz"}},
+	"zlib":          {{"zlib", "zlib"}},
+	"zstd":          {{"facebook", "zstandard"}},
+}
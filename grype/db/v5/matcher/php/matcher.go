@@ -0,0 +1,50 @@
+package php
+
+import (
+	"errors"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/internal/log"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	UseCPEs bool
+}
+
+func NewPhpComposerMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.PhpComposerPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.PhpComposerMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	if _, err := version.NewVersion(p.Version, version.ComposerFormat); errors.Is(err, version.ErrUnsupportedVersion) {
+		// packages installed from a named branch (e.g. "dev-main") cannot be ordered relative to the affected releases
+		log.WithFields("package", p.Name, "version", p.Version).Debug("skipping composer package installed from a development branch")
+		return nil, nil
+	}
+
+	criteria := search.CommonCriteria
+	if m.cfg.UseCPEs {
+		criteria = append(criteria, search.ByCPE)
+	}
+	return search.ByCriteria(store, d, p, m.Type(), criteria...)
+}
@@ -0,0 +1,109 @@
+package php
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected []string
+	}{
+		{
+			name:     "vulnerable release",
+			version:  "v2.3.0",
+			expected: []string{"GHSA-laravel"},
+		},
+		{
+			name:     "vulnerable pre-release",
+			version:  "2.0.0-beta2",
+			expected: []string{"GHSA-laravel"},
+		},
+		{
+			name:    "pre-release before the affected range",
+			version: "2.0.0-alpha1",
+		},
+		{
+			name:    "fixed release",
+			version: "2.4.1",
+		},
+		{
+			name:     "numeric development branch",
+			version:  "2.3.x-dev",
+			expected: []string{"GHSA-laravel"},
+		},
+		{
+			name:    "named development branch is skipped",
+			version: "dev-main",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := pkg.Package{
+				ID:       pkg.ID(uuid.NewString()),
+				Name:     "laravel/framework",
+				Version:  test.version,
+				Type:     syftPkg.PhpComposerPkg,
+				Language: syftPkg.PHP,
+			}
+
+			matcher := NewPhpComposerMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, p)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{
+		data: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			syftPkg.PHP: {
+				"laravel/framework": {
+					{
+						Constraint: version.MustGetConstraint(">= 2.0.0-beta1, < 2.4.1", version.ComposerFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-laravel", Namespace: "github:language:php"},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	data map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.data[l][p.Name], nil
+}
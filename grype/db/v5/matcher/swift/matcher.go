@@ -0,0 +1,85 @@
+package swift
+
+import (
+	"strings"
+
+	"github.com/anchore/packageurl-go"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	"github.com/anchore/grype/grype/db/v5/search"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/internal/log"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+type Matcher struct {
+	cfg MatcherConfig
+}
+
+type MatcherConfig struct {
+	UseCPEs bool
+}
+
+func NewSwiftMatcher(cfg MatcherConfig) *Matcher {
+	return &Matcher{
+		cfg: cfg,
+	}
+}
+
+func (m *Matcher) PackageTypes() []syftPkg.Type {
+	return []syftPkg.Type{syftPkg.SwiftPkg, syftPkg.CocoapodsPkg}
+}
+
+func (m *Matcher) Type() match.MatcherType {
+	return match.SwiftMatcher
+}
+
+func (m *Matcher) Match(store v5.VulnerabilityProvider, d *distro.Distro, p pkg.Package) ([]match.Match, error) {
+	criteria := search.CommonCriteria
+	if m.cfg.UseCPEs {
+		criteria = append(criteria, search.ByCPE)
+	}
+
+	// GitHub advisories for the swift ecosystem are keyed by the repository of the package (e.g. "github.com/vapor/vapor")
+	// and are in the swift language namespace, which CocoaPods packages are searched against as well
+	searchPkg := p
+	searchPkg.Name = advisoryPackageName(p)
+	searchPkg.Language = syftPkg.Swift
+
+	matches, err := search.ByCriteria(store, d, searchPkg, m.Type(), criteria...)
+	for i := range matches {
+		matches[i].Package = p
+	}
+	return matches, err
+}
+
+// advisoryPackageName returns the name of the package as used by GitHub security advisories for swift packages, which
+// is the source repository of the package (e.g. "github.com/vapor/vapor"). CocoaPods packages (and swift packages
+// without a package URL) are searched by their name.
+func advisoryPackageName(p pkg.Package) string {
+	if p.Type != syftPkg.SwiftPkg || p.PURL == "" {
+		return p.Name
+	}
+
+	purl, err := packageurl.FromString(p.PURL)
+	if err != nil {
+		log.WithFields("purl", p.PURL, "error", err).Debug("unable to parse swift package URL")
+		return p.Name
+	}
+
+	if purl.Namespace == "" {
+		return p.Name
+	}
+
+	// syft uses the full source repository as the namespace ("pkg:swift/github.com/vapor/vapor.git/vapor@4.0.0"),
+	// while the purl spec uses the repository owner as the namespace and the repository as the name
+	// ("pkg:swift/github.com/vapor/vapor@4.0.0")
+	repository := strings.TrimSuffix(purl.Namespace, ".git")
+	if strings.Count(repository, "/") < 2 {
+		repository += "/" + strings.TrimSuffix(purl.Name, ".git")
+	}
+
+	return repository
+}
@@ -0,0 +1,136 @@
+package swift
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name     string
+		p        pkg.Package
+		expected []string
+	}{
+		{
+			name: "swift package is searched by the source repository in the package URL",
+			p: pkg.Package{
+				Name:     "vapor",
+				Version:  "4.0.0",
+				Type:     syftPkg.SwiftPkg,
+				Language: syftPkg.Swift,
+				PURL:     "pkg:swift/github.com/vapor/vapor@4.0.0",
+			},
+			expected: []string{"GHSA-vapor"},
+		},
+		{
+			name: "swift package with the source repository as the package URL namespace",
+			p: pkg.Package{
+				Name:     "vapor",
+				Version:  "4.0.0",
+				Type:     syftPkg.SwiftPkg,
+				Language: syftPkg.Swift,
+				PURL:     "pkg:swift/github.com/vapor/vapor.git/vapor@4.0.0",
+			},
+			expected: []string{"GHSA-vapor"},
+		},
+		{
+			name: "swift package without a package URL is searched by name",
+			p: pkg.Package{
+				Name:     "github.com/vapor/vapor",
+				Version:  "4.0.0",
+				Type:     syftPkg.SwiftPkg,
+				Language: syftPkg.Swift,
+			},
+			expected: []string{"GHSA-vapor"},
+		},
+		{
+			name: "swift package with a fixed version",
+			p: pkg.Package{
+				Name:     "vapor",
+				Version:  "4.1.0",
+				Type:     syftPkg.SwiftPkg,
+				Language: syftPkg.Swift,
+				PURL:     "pkg:swift/github.com/vapor/vapor@4.1.0",
+			},
+		},
+		{
+			name: "cocoapods package is searched by name",
+			p: pkg.Package{
+				Name:     "AFNetworking",
+				Version:  "2.5.0",
+				Type:     syftPkg.CocoapodsPkg,
+				Language: syftPkg.Swift,
+				PURL:     "pkg:cocoapods/AFNetworking@2.5.0",
+			},
+			expected: []string{"GHSA-afnetworking"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.p.ID = pkg.ID(uuid.NewString())
+
+			matcher := NewSwiftMatcher(MatcherConfig{})
+			actual, err := matcher.Match(newMockProvider(), nil, test.p)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, m := range actual {
+				ids = append(ids, m.Vulnerability.ID)
+				assert.Equal(t, test.p, m.Package, "matches should reference the original package")
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{
+		data: map[syftPkg.Language]map[string][]vulnerability.Vulnerability{
+			syftPkg.Swift: {
+				"github.com/vapor/vapor": {
+					{
+						Constraint: version.MustGetConstraint("< 4.1.0", version.UnknownFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-vapor", Namespace: "github:language:swift"},
+					},
+				},
+				"AFNetworking": {
+					{
+						Constraint: version.MustGetConstraint("< 2.5.3", version.UnknownFormat),
+						Reference:  vulnerability.Reference{ID: "GHSA-afnetworking", Namespace: "github:language:swift"},
+					},
+				},
+			},
+		},
+	}
+}
+
+type mockProvider struct {
+	data map[syftPkg.Language]map[string][]vulnerability.Vulnerability
+}
+
+func (mp *mockProvider) Get(_, _ string) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByCPE(_ cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByDistro(_ *distro.Distro, _ pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return nil, nil
+}
+
+func (mp *mockProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	return mp.data[l][p.Name], nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 
+	"github.com/anchore/grype/grype/db/v5/pkg/qualifier/goimports"
 	"github.com/anchore/grype/grype/db/v5/pkg/qualifier/platformcpe"
 	"github.com/anchore/grype/grype/db/v5/pkg/qualifier/rpmmodularity"
 	"github.com/anchore/grype/internal/log"
@@ -42,6 +43,13 @@ func FromJSON(data []byte) ([]Qualifier, error) {
 				continue
 			}
 			qualifiers = append(qualifiers, q)
+		case "go-imports":
+			var q goimports.Qualifier
+			if err := mapstructure.Decode(r, &q); err != nil {
+				log.Warn("Error decoding go-imports package qualifier:  (%v)", err)
+				continue
+			}
+			qualifiers = append(qualifiers, q)
 		default:
 			log.Debug("Skipping unsupported package qualifier: %s", k)
 			continue
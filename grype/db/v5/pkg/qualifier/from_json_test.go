@@ -0,0 +1,36 @@
+package qualifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/db/v5/pkg/qualifier/goimports"
+	"github.com/anchore/grype/grype/db/v5/pkg/qualifier/rpmmodularity"
+)
+
+func TestFromJSON(t *testing.T) {
+	data := []byte(`[
+		{"kind": "rpm-modularity", "module": "nodejs:16"},
+		{"kind": "go-imports", "imports": [
+			{"path": "golang.org/x/net/html", "symbols": ["Parse", "Tokenizer.Next"]},
+			{"path": "golang.org/x/net/http2"}
+		]},
+		{"kind": "something-new"}
+	]`)
+
+	qualifiers, err := FromJSON(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Qualifier{
+		rpmmodularity.Qualifier{Kind: "rpm-modularity", Module: "nodejs:16"},
+		goimports.Qualifier{
+			Kind: "go-imports",
+			Imports: []goimports.Import{
+				{Path: "golang.org/x/net/html", Symbols: []string{"Parse", "Tokenizer.Next"}},
+				{Path: "golang.org/x/net/http2"},
+			},
+		},
+	}, qualifiers)
+}
@@ -0,0 +1,30 @@
+package goimports
+
+import (
+	"fmt"
+
+	"github.com/anchore/grype/grype/pkg/qualifier"
+	"github.com/anchore/grype/grype/pkg/qualifier/goimports"
+)
+
+type Qualifier struct {
+	Kind    string   `json:"kind" mapstructure:"kind"`                           // Kind of qualifier
+	Imports []Import `json:"imports,omitempty" mapstructure:"imports,omitempty"` // Go packages affected by the vulnerability (OSV ecosystem_specific.imports)
+}
+
+type Import struct {
+	Path    string   `json:"path" mapstructure:"path"`                           // Import path of the affected package
+	Symbols []string `json:"symbols,omitempty" mapstructure:"symbols,omitempty"` // Affected symbols of the package, all symbols when empty
+}
+
+func (q Qualifier) Parse() qualifier.Qualifier {
+	imports := make([]goimports.Import, 0, len(q.Imports))
+	for _, i := range q.Imports {
+		imports = append(imports, goimports.Import{Path: i.Path, Symbols: i.Symbols})
+	}
+	return goimports.New(imports)
+}
+
+func (q Qualifier) String() string {
+	return fmt.Sprintf("kind: %s, imports: %+v", q.Kind, q.Imports)
+}
@@ -124,6 +124,18 @@ func ByPackageCPE(store v5.ProviderByCPE, d *distro.Distro, p pkg.Package, upstr
 		}
 
 		verObj, err := version.NewVersion(searchVersion, format)
+		if err != nil && format == version.NpmFormat && !errors.Is(err, version.ErrUnsupportedVersion) {
+			// CPE versions do not always follow the strict semver that npm requires (e.g. "0.1"), fall back to
+			// fuzzy version comparisons for these
+			log.WithFields("package", p.Name, "version", searchVersion).Trace("unable to parse npm version, using fuzzy comparisons")
+			verObj, err = version.NewVersion(searchVersion, version.UnknownFormat)
+		}
+		if err != nil && format == version.NuGetFormat {
+			// .NET versions read from PE metadata are free-form (e.g. "Release 73"), fall back to fuzzy version
+			// comparisons for these
+			log.WithFields("package", p.Name, "version", searchVersion).Trace("unable to parse nuget version, using fuzzy comparisons")
+			verObj, err = version.NewVersion(searchVersion, version.UnknownFormat)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("matcher failed to parse version pkg=%q ver=%q: %w", p.Name, p.Version, err)
 		}
@@ -865,6 +865,54 @@ func TestFindMatchesByPackageCPE(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "dotnet versions from PE metadata fall back to fuzzy comparisons",
+			p: pkg.Package{
+				CPEs: []cpe.CPE{
+					cpe.Must("cpe:2.3:*:multiple:multiple:*:*:*:*:*:*:*:*", ""),
+				},
+				Name:     "multiple",
+				Version:  "1.0.0.0.1",
+				Language: syftPkg.Dotnet,
+				Type:     syftPkg.DotnetPkg,
+			},
+			expected: []match.Match{
+				{
+					Vulnerability: vulnerability.Vulnerability{
+						Reference: vulnerability.Reference{ID: "CVE-2017-fake-5"},
+					},
+					Package: pkg.Package{
+						CPEs: []cpe.CPE{
+							cpe.Must("cpe:2.3:*:multiple:multiple:*:*:*:*:*:*:*:*", ""),
+						},
+						Name:     "multiple",
+						Version:  "1.0.0.0.1",
+						Language: syftPkg.Dotnet,
+						Type:     syftPkg.DotnetPkg,
+					},
+					Details: []match.Detail{
+						{
+							Type:       match.CPEMatch,
+							Confidence: 0.9,
+							SearchedBy: CPEParameters{
+								CPEs:      []string{"cpe:2.3:*:multiple:multiple:1.0.0.0.1:*:*:*:*:*:*:*"},
+								Namespace: "nvd:cpe",
+								Package: CPEPackageParameter{
+									Name:    "multiple",
+									Version: "1.0.0.0.1",
+								},
+							},
+							Found: CPEResult{
+								CPEs:              []string{"cpe:2.3:*:multiple:multiple:*:*:*:*:*:*:*:*"},
+								VersionConstraint: "< 4.0 (unknown)",
+								VulnerabilityID:   "CVE-2017-fake-5",
+							},
+							Matcher: matcher,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
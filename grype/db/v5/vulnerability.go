@@ -5,11 +5,13 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/anchore/grype/grype/db/v5/namespace/language"
 	qualifierV5 "github.com/anchore/grype/grype/db/v5/pkg/qualifier"
 	"github.com/anchore/grype/grype/pkg/qualifier"
 	"github.com/anchore/grype/grype/version"
 	"github.com/anchore/grype/grype/vulnerability"
 	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
 )
 
 // Vulnerability represents the minimum data fields necessary to perform package-to-vulnerability matching. This can represent a CVE, 3rd party advisory, or any source that relates back to a CVE.
@@ -118,8 +120,37 @@ func sortPackageQualifiers(qualifiers []qualifierV5.Qualifier) []qualifierV5.Qua
 	return qualifiers
 }
 
+// formatFromNamespace returns the version format implied by the ecosystem of the given namespace, for records that do
+// not specify a version format (e.g. GHSA NuGet, npm, Composer, Pub and Hex advisories, or kernel.org CNA ranges).
+func formatFromNamespace(namespace string) version.Format {
+	ns, err := language.FromString(namespace)
+	if err != nil {
+		return version.UnknownFormat
+	}
+
+	switch ns.Language() {
+	case syftPkg.Dotnet:
+		return version.NuGetFormat
+	case syftPkg.JavaScript:
+		return version.NpmFormat
+	case syftPkg.PHP:
+		return version.ComposerFormat
+	case syftPkg.Dart:
+		return version.PubFormat
+	case syftPkg.Elixir, syftPkg.Erlang:
+		return version.HexFormat
+	case "linux-kernel":
+		// affected ranges published by the kernel.org CNA
+		return version.KernelFormat
+	}
+	return version.UnknownFormat
+}
+
 func NewVulnerability(vuln Vulnerability) (*vulnerability.Vulnerability, error) {
 	format := version.ParseFormat(vuln.VersionFormat)
+	if format == version.UnknownFormat {
+		format = formatFromNamespace(vuln.Namespace)
+	}
 
 	constraint, err := version.GetConstraint(vuln.VersionConstraint, format)
 	if err != nil {
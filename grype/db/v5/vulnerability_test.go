@@ -0,0 +1,105 @@
+package v5
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVulnerability_VersionFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		vuln      Vulnerability
+		wantConst string
+	}{
+		{
+			name: "explicit format",
+			vuln: Vulnerability{
+				ID:                "CVE-2024-0001",
+				Namespace:         "debian:distro:debian:8",
+				VersionConstraint: "< 1.0",
+				VersionFormat:     "deb",
+			},
+			wantConst: "< 1.0 (deb)",
+		},
+		{
+			name: "GHSA nuget advisory",
+			vuln: Vulnerability{
+				ID:                "GHSA-xxxx-xxxx-xxxx",
+				Namespace:         "github:language:dotnet",
+				VersionConstraint: "[1.0,2.0)",
+				VersionFormat:     "unknown",
+			},
+			wantConst: "[1.0,2.0) (nuget)",
+		},
+		{
+			name: "GHSA npm advisory",
+			vuln: Vulnerability{
+				ID:                "GHSA-xxxx-xxxx-xxxx",
+				Namespace:         "github:language:javascript",
+				VersionConstraint: ">= 1.0.0, < 1.2.3",
+				VersionFormat:     "unknown",
+			},
+			wantConst: ">= 1.0.0, < 1.2.3 (npm)",
+		},
+		{
+			name: "GHSA composer advisory",
+			vuln: Vulnerability{
+				ID:                "GHSA-xxxx-xxxx-xxxx",
+				Namespace:         "github:language:php",
+				VersionConstraint: ">= 2.0.0-beta1, < 2.4.1",
+				VersionFormat:     "unknown",
+			},
+			wantConst: ">= 2.0.0-beta1, < 2.4.1 (composer)",
+		},
+		{
+			name: "GHSA pub advisory",
+			vuln: Vulnerability{
+				ID:                "GHSA-xxxx-xxxx-xxxx",
+				Namespace:         "github:language:dart",
+				VersionConstraint: "< 1.0.0-nullsafety.0",
+				VersionFormat:     "unknown",
+			},
+			wantConst: "< 1.0.0-nullsafety.0 (pub)",
+		},
+		{
+			name: "GHSA hex advisory",
+			vuln: Vulnerability{
+				ID:                "GHSA-xxxx-xxxx-xxxx",
+				Namespace:         "github:language:erlang",
+				VersionConstraint: ">= 1.3.0, < 1.3.5",
+				VersionFormat:     "unknown",
+			},
+			wantConst: ">= 1.3.0, < 1.3.5 (hex)",
+		},
+		{
+			name: "kernel.org CNA range",
+			vuln: Vulnerability{
+				ID:                "CVE-2024-26581",
+				Namespace:         "kernel.org:language:linux-kernel",
+				VersionConstraint: ">= 5.10.150, < 5.10.209 || >= 6.1, < 6.1.78",
+				VersionFormat:     "unknown",
+			},
+			wantConst: ">= 5.10.150, < 5.10.209 || >= 6.1, < 6.1.78 (kernel)",
+		},
+		{
+			name: "unknown format for other ecosystems",
+			vuln: Vulnerability{
+				ID:                "GHSA-xxxx-xxxx-xxxx",
+				Namespace:         "github:language:python",
+				VersionConstraint: "< 1.0",
+				VersionFormat:     "unknown",
+			},
+			wantConst: "< 1.0 (unknown)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			v, err := NewVulnerability(test.vuln)
+			require.NoError(t, err)
+			assert.Equal(t, test.wantConst, v.Constraint.String())
+		})
+	}
+}
@@ -28,6 +28,37 @@ type VulnerabilityBlob struct {
 	Severities []Severity `json:"severities,omitempty"`
 }
 
+// KnownExploitedVulnerabilityBlob represents the details of a single entry in the CISA Known Exploited
+// Vulnerabilities (KEV) catalog.
+type KnownExploitedVulnerabilityBlob struct {
+	// Cve is the CVE identifier of the exploited vulnerability
+	Cve string `json:"cve"`
+
+	// VendorProject is the vendor or project name for the vulnerable product
+	VendorProject string `json:"vendor_project,omitempty"`
+
+	// Product is the name of the vulnerable product
+	Product string `json:"product,omitempty"`
+
+	// DateAdded is the date the vulnerability was added to the catalog
+	DateAdded *time.Time `json:"date_added,omitempty"`
+
+	// RequiredAction is the action that is required to address the vulnerability
+	RequiredAction string `json:"required_action,omitempty"`
+
+	// DueDate is the date the required action is due (for US federal civilian executive branch agencies)
+	DueDate *time.Time `json:"due_date,omitempty"`
+
+	// KnownRansomwareCampaignUse is whether the vulnerability is known to be used in ransomware campaigns ("Known" or "Unknown")
+	KnownRansomwareCampaignUse string `json:"known_ransomware_campaign_use,omitempty"`
+
+	// Notes are any additional notes about the vulnerability
+	Notes string `json:"notes,omitempty"`
+
+	// URLs are references to additional information about the vulnerability
+	URLs []string `json:"urls,omitempty"`
+}
+
 // Reference represents a single external URL and string tags to use for organizational purposes
 type Reference struct {
 	// URL is the external resource
@@ -167,3 +198,34 @@ type AffectedVersion struct {
 	// Constraint defines the version range constraint for affected versions.
 	Constraint string `json:"constraint"`
 }
+
+// MatchExclusionBlob represents the conditions under which matches for a vulnerability are excluded from the results.
+type MatchExclusionBlob struct {
+	// Constraints are the conditions under which the exclusion applies (when empty, all matches for the vulnerability
+	// are excluded). The exclusion applies when any single constraint is met.
+	Constraints []MatchExclusionConstraint `json:"constraints,omitempty"`
+
+	// Justification is the reason that matches are excluded
+	Justification string `json:"justification"`
+}
+
+// MatchExclusionConstraint describes the matches that are excluded, where all specified fields must be met.
+type MatchExclusionConstraint struct {
+	// Namespace is the vulnerability namespace of the match (e.g. "nvd:cpe")
+	Namespace string `json:"namespace,omitempty"`
+
+	// FixState is the fix state of the vulnerability (e.g. "not-fixed")
+	FixState string `json:"fix_state,omitempty"`
+
+	// Package describes the package of the match
+	Package *MatchExclusionPackage `json:"package,omitempty"`
+}
+
+// MatchExclusionPackage describes the package of a match that is excluded, where all specified fields must be met.
+type MatchExclusionPackage struct {
+	Name     string `json:"name,omitempty"`
+	Language string `json:"language,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Version  string `json:"version,omitempty"`
+	Location string `json:"location,omitempty"`
+}
@@ -23,7 +23,7 @@ const (
 	Revision = 0
 
 	// Addition indicates how many changes have been introduced that are compatible with all historical data
-	Addition = 0
+	Addition = 3
 )
 
 type ReadWriter interface {
@@ -37,6 +37,9 @@ type Reader interface {
 	VulnerabilityStoreReader
 	AffectedPackageStoreReader
 	AffectedCPEStoreReader
+	EpssStoreReader
+	KnownExploitedVulnerabilityStoreReader
+	MatchExclusionStoreReader
 }
 
 type Writer interface {
@@ -44,6 +47,9 @@ type Writer interface {
 	VulnerabilityStoreWriter
 	AffectedPackageStoreWriter
 	AffectedCPEStoreWriter
+	EpssStoreWriter
+	KnownExploitedVulnerabilityStoreWriter
+	MatchExclusionStoreWriter
 	io.Closer
 }
 
@@ -0,0 +1,54 @@
+package v6
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/anchore/grype/internal/log"
+)
+
+type EpssStoreWriter interface {
+	AddEpss(epss ...*EpssHandle) error
+}
+
+type EpssStoreReader interface {
+	GetEpss(cve string) ([]EpssHandle, error)
+}
+
+type epssStore struct {
+	db *gorm.DB
+}
+
+func newEpssStore(db *gorm.DB) *epssStore {
+	return &epssStore{
+		db: db,
+	}
+}
+
+// AddEpss adds one or more EPSS records to the store
+func (s *epssStore) AddEpss(epss ...*EpssHandle) error {
+	for _, e := range epss {
+		if e == nil {
+			continue
+		}
+		if err := s.db.Create(e).Error; err != nil {
+			return fmt.Errorf("unable to add EPSS record for %q: %w", e.Cve, err)
+		}
+	}
+	return nil
+}
+
+// GetEpss retrieves all EPSS records for the given CVE, with the most recent scores first
+func (s *epssStore) GetEpss(cve string) ([]EpssHandle, error) {
+	log.WithFields("cve", cve).Trace("fetching EPSS records")
+
+	var models []EpssHandle
+	result := s.db.Where("cve = ? collate nocase", strings.TrimSpace(cve)).Order("date DESC").Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to fetch EPSS records (cve=%q): %w", cve, result.Error)
+	}
+
+	return models, nil
+}
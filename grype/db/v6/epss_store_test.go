@@ -0,0 +1,34 @@
+package v6
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEpssStore(t *testing.T) {
+	s := newEpssStore(setupTestStore(t).db)
+
+	older := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, s.AddEpss(
+		&EpssHandle{Cve: "CVE-2021-44228", Epss: 0.94, Percentile: 0.99, Date: older},
+		&EpssHandle{Cve: "CVE-2021-44228", Epss: 0.97, Percentile: 0.999, Date: newer},
+		&EpssHandle{Cve: "CVE-2024-0001", Epss: 0.01, Percentile: 0.25, Date: newer},
+	))
+
+	results, err := s.GetEpss("cve-2021-44228")
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, 0.97, results[0].Epss)
+	assert.Equal(t, 0.999, results[0].Percentile)
+	assert.True(t, newer.Equal(results[0].Date))
+	assert.Equal(t, 0.94, results[1].Epss)
+
+	results, err = s.GetEpss("CVE-0000-0000")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
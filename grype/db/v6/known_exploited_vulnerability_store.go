@@ -0,0 +1,69 @@
+package v6
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/anchore/grype/internal/log"
+)
+
+type KnownExploitedVulnerabilityStoreWriter interface {
+	AddKnownExploitedVulnerabilities(kevs ...*KnownExploitedVulnerabilityHandle) error
+}
+
+type KnownExploitedVulnerabilityStoreReader interface {
+	GetKnownExploitedVulnerabilities(cve string) ([]KnownExploitedVulnerabilityHandle, error)
+}
+
+type knownExploitedVulnerabilityStore struct {
+	db        *gorm.DB
+	blobStore *blobStore
+}
+
+func newKnownExploitedVulnerabilityStore(db *gorm.DB, bs *blobStore) *knownExploitedVulnerabilityStore {
+	return &knownExploitedVulnerabilityStore{
+		db:        db,
+		blobStore: bs,
+	}
+}
+
+// AddKnownExploitedVulnerabilities adds one or more KEV catalog entries to the store
+func (s *knownExploitedVulnerabilityStore) AddKnownExploitedVulnerabilities(kevs ...*KnownExploitedVulnerabilityHandle) error {
+	for _, k := range kevs {
+		if k == nil {
+			continue
+		}
+
+		if err := s.blobStore.addBlobable(k); err != nil {
+			return fmt.Errorf("unable to add known exploited vulnerability blob: %w", err)
+		}
+
+		if err := s.db.Create(k).Error; err != nil {
+			return fmt.Errorf("unable to add known exploited vulnerability for %q: %w", k.Cve, err)
+		}
+	}
+	return nil
+}
+
+// GetKnownExploitedVulnerabilities retrieves all KEV catalog entries (with blob values attached) for the given CVE
+func (s *knownExploitedVulnerabilityStore) GetKnownExploitedVulnerabilities(cve string) ([]KnownExploitedVulnerabilityHandle, error) {
+	log.WithFields("cve", cve).Trace("fetching known exploited vulnerability records")
+
+	var models []KnownExploitedVulnerabilityHandle
+	result := s.db.Where("cve = ? collate nocase", strings.TrimSpace(cve)).Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to fetch known exploited vulnerability records (cve=%q): %w", cve, result.Error)
+	}
+
+	var blobs []blobable
+	for i := range models {
+		blobs = append(blobs, &models[i])
+	}
+	if err := s.blobStore.attachBlobValue(blobs...); err != nil {
+		return nil, fmt.Errorf("unable to attach known exploited vulnerability blobs: %w", err)
+	}
+
+	return models, nil
+}
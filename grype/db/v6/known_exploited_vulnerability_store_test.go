@@ -0,0 +1,45 @@
+package v6
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKnownExploitedVulnerabilityStore(t *testing.T) {
+	db := setupTestStore(t).db
+	s := newKnownExploitedVulnerabilityStore(db, newBlobStore(db))
+
+	added := time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC)
+
+	kev := &KnownExploitedVulnerabilityHandle{
+		Cve: "CVE-2021-44228",
+		BlobValue: &KnownExploitedVulnerabilityBlob{
+			Cve:                        "CVE-2021-44228",
+			VendorProject:              "Apache",
+			Product:                    "Log4j2",
+			DateAdded:                  &added,
+			RequiredAction:             "For all affected software assets for which updates exist, the only acceptable remediation actions are: 1) Apply updates; OR 2) remove affected assets from agency networks.",
+			DueDate:                    &due,
+			KnownRansomwareCampaignUse: "Known",
+			URLs:                       []string{"https://logging.apache.org/log4j/2.x/security.html"},
+		},
+	}
+	require.NoError(t, s.AddKnownExploitedVulnerabilities(kev))
+	assert.NotZero(t, kev.BlobID)
+
+	results, err := s.GetKnownExploitedVulnerabilities("cve-2021-44228")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	if d := cmp.Diff(*kev, results[0]); d != "" {
+		t.Errorf("unexpected known exploited vulnerability (-want +got): %s", d)
+	}
+
+	results, err = s.GetKnownExploitedVulnerabilities("CVE-0000-0000")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
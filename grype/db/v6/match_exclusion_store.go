@@ -0,0 +1,69 @@
+package v6
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/anchore/grype/internal/log"
+)
+
+type MatchExclusionStoreWriter interface {
+	AddMatchExclusions(exclusions ...*MatchExclusionHandle) error
+}
+
+type MatchExclusionStoreReader interface {
+	GetMatchExclusions(vulnerabilityID string) ([]MatchExclusionHandle, error)
+}
+
+type matchExclusionStore struct {
+	db        *gorm.DB
+	blobStore *blobStore
+}
+
+func newMatchExclusionStore(db *gorm.DB, bs *blobStore) *matchExclusionStore {
+	return &matchExclusionStore{
+		db:        db,
+		blobStore: bs,
+	}
+}
+
+// AddMatchExclusions adds one or more match exclusions to the store
+func (s *matchExclusionStore) AddMatchExclusions(exclusions ...*MatchExclusionHandle) error {
+	for _, e := range exclusions {
+		if e == nil {
+			continue
+		}
+
+		if err := s.blobStore.addBlobable(e); err != nil {
+			return fmt.Errorf("unable to add match exclusion blob: %w", err)
+		}
+
+		if err := s.db.Create(e).Error; err != nil {
+			return fmt.Errorf("unable to add match exclusion for %q: %w", e.VulnerabilityID, err)
+		}
+	}
+	return nil
+}
+
+// GetMatchExclusions retrieves all match exclusions (with blob values attached) for the given vulnerability ID
+func (s *matchExclusionStore) GetMatchExclusions(vulnerabilityID string) ([]MatchExclusionHandle, error) {
+	log.WithFields("vulnerability", vulnerabilityID).Trace("fetching match exclusion records")
+
+	var models []MatchExclusionHandle
+	result := s.db.Where("vulnerability_id = ? collate nocase", strings.TrimSpace(vulnerabilityID)).Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to fetch match exclusion records (vulnerability=%q): %w", vulnerabilityID, result.Error)
+	}
+
+	var blobs []blobable
+	for i := range models {
+		blobs = append(blobs, &models[i])
+	}
+	if err := s.blobStore.attachBlobValue(blobs...); err != nil {
+		return nil, fmt.Errorf("unable to attach match exclusion blobs: %w", err)
+	}
+
+	return models, nil
+}
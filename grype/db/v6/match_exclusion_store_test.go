@@ -0,0 +1,40 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchExclusionStore(t *testing.T) {
+	db := setupTestStore(t).db
+	s := newMatchExclusionStore(db, newBlobStore(db))
+
+	exclusion := &MatchExclusionHandle{
+		VulnerabilityID: "CVE-2020-1234",
+		BlobValue: &MatchExclusionBlob{
+			Justification: "only affects the windows build",
+			Constraints: []MatchExclusionConstraint{
+				{
+					Namespace: "nvd:cpe",
+					Package:   &MatchExclusionPackage{Name: "foo", Language: "python"},
+				},
+			},
+		},
+	}
+	require.NoError(t, s.AddMatchExclusions(exclusion))
+	assert.NotZero(t, exclusion.BlobID)
+
+	results, err := s.GetMatchExclusions("cve-2020-1234")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	if d := cmp.Diff(*exclusion, results[0]); d != "" {
+		t.Errorf("unexpected match exclusion (-want +got): %s", d)
+	}
+
+	results, err = s.GetMatchExclusions("CVE-0000-0000")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
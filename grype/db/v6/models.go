@@ -38,6 +38,13 @@ func Models() []any {
 		// CPE related search tables
 		&AffectedCPEHandle{}, // join on CPE
 		&Cpe{},
+
+		// exploit related search tables
+		&EpssHandle{},
+		&KnownExploitedVulnerabilityHandle{},
+
+		// false positive related search tables
+		&MatchExclusionHandle{},
 	}
 }
 
@@ -473,3 +480,93 @@ func cpeWhereClause(tx *gorm.DB, c *Cpe) *gorm.DB {
 	}
 	return tx.Where("part = ? AND vendor = ? AND product = ? AND edition = ? AND language = ? AND software_edition = ? AND target_hardware = ? AND target_software = ? AND other = ? collate nocase", c.Part, c.Vendor, c.Product, c.Edition, c.Language, c.SoftwareEdition, c.TargetHardware, c.TargetSoftware, c.Other)
 }
+
+// exploit related search tables //////////////////////////////////////////////////////
+
+// EpssHandle represents the Exploit Prediction Scoring System (EPSS) data for a single CVE at a point in time, as
+// published by FIRST (see https://www.first.org/epss).
+type EpssHandle struct {
+	ID ID `gorm:"column:id;primaryKey"`
+
+	// Cve is the CVE identifier that the EPSS data describes (e.g. "CVE-2021-44228")
+	Cve string `gorm:"column:cve;not null;index:epss_cve_idx,collate:NOCASE"`
+
+	// Epss is the probability of exploitation activity in the next 30 days (0-1)
+	Epss float64 `gorm:"column:epss;not null"`
+
+	// Percentile is the proportion of all scored vulnerabilities with the same or a lower EPSS score (0-1)
+	Percentile float64 `gorm:"column:percentile;not null"`
+
+	// Date is the date of the EPSS model run that produced the score
+	Date time.Time `gorm:"column:date;not null"`
+}
+
+// KnownExploitedVulnerabilityHandle represents a single entry in the CISA Known Exploited Vulnerabilities (KEV)
+// catalog (see https://www.cisa.gov/known-exploited-vulnerabilities-catalog).
+type KnownExploitedVulnerabilityHandle struct {
+	ID ID `gorm:"column:id;primaryKey"`
+
+	// Cve is the CVE identifier of the exploited vulnerability (e.g. "CVE-2021-44228")
+	Cve string `gorm:"column:cve;not null;index:kev_cve_idx,collate:NOCASE"`
+
+	BlobID    ID                               `gorm:"column:blob_id"`
+	BlobValue *KnownExploitedVulnerabilityBlob `gorm:"-"`
+}
+
+func (v KnownExploitedVulnerabilityHandle) getBlobValue() any {
+	return v.BlobValue
+}
+
+func (v *KnownExploitedVulnerabilityHandle) setBlobID(id ID) {
+	v.BlobID = id
+}
+
+func (v KnownExploitedVulnerabilityHandle) getBlobID() ID {
+	return v.BlobID
+}
+
+func (v *KnownExploitedVulnerabilityHandle) setBlob(rawBlobValue []byte) error {
+	var blobValue KnownExploitedVulnerabilityBlob
+	if err := json.Unmarshal(rawBlobValue, &blobValue); err != nil {
+		return fmt.Errorf("unable to unmarshal known exploited vulnerability blob value: %w", err)
+	}
+
+	v.BlobValue = &blobValue
+	return nil
+}
+
+// false positive related search tables //////////////////////////////////////////////////////
+
+// MatchExclusionHandle represents a curated rule for suppressing matches of a vulnerability that are known to be false
+// positives (e.g. a CVE that only affects the Windows build of a package).
+type MatchExclusionHandle struct {
+	ID ID `gorm:"column:id;primaryKey"`
+
+	// VulnerabilityID is the identifier of the vulnerability or advisory that matches are excluded for (e.g. "CVE-2021-44228")
+	VulnerabilityID string `gorm:"column:vulnerability_id;not null;index:match_exclusion_vulnerability_id_idx,collate:NOCASE"`
+
+	BlobID    ID                  `gorm:"column:blob_id"`
+	BlobValue *MatchExclusionBlob `gorm:"-"`
+}
+
+func (v MatchExclusionHandle) getBlobValue() any {
+	return v.BlobValue
+}
+
+func (v *MatchExclusionHandle) setBlobID(id ID) {
+	v.BlobID = id
+}
+
+func (v MatchExclusionHandle) getBlobID() ID {
+	return v.BlobID
+}
+
+func (v *MatchExclusionHandle) setBlob(rawBlobValue []byte) error {
+	var blobValue MatchExclusionBlob
+	if err := json.Unmarshal(rawBlobValue, &blobValue); err != nil {
+		return fmt.Errorf("unable to unmarshal match exclusion blob value: %w", err)
+	}
+
+	v.BlobValue = &blobValue
+	return nil
+}
@@ -15,6 +15,9 @@ type store struct {
 	*vulnerabilityStore
 	*affectedPackageStore
 	*affectedCPEStore
+	*epssStore
+	*knownExploitedVulnerabilityStore
+	*matchExclusionStore
 	blobStore *blobStore
 	db        *gorm.DB
 	config    Config
@@ -48,15 +51,18 @@ func newStore(cfg Config, empty, writable bool) (*store, error) {
 
 	bs := newBlobStore(db)
 	return &store{
-		dbMetadataStore:      newDBMetadataStore(db),
-		providerStore:        newProviderStore(db),
-		vulnerabilityStore:   newVulnerabilityStore(db, bs),
-		affectedPackageStore: newAffectedPackageStore(db, bs),
-		affectedCPEStore:     newAffectedCPEStore(db, bs),
-		blobStore:            bs,
-		db:                   db,
-		config:               cfg,
-		readOnly:             !empty && !writable,
+		dbMetadataStore:                  newDBMetadataStore(db),
+		providerStore:                    newProviderStore(db),
+		vulnerabilityStore:               newVulnerabilityStore(db, bs),
+		affectedPackageStore:             newAffectedPackageStore(db, bs),
+		affectedCPEStore:                 newAffectedCPEStore(db, bs),
+		epssStore:                        newEpssStore(db),
+		knownExploitedVulnerabilityStore: newKnownExploitedVulnerabilityStore(db, bs),
+		matchExclusionStore:              newMatchExclusionStore(db, bs),
+		blobStore:                        bs,
+		db:                               db,
+		config:                           cfg,
+		readOnly:                         !empty && !writable,
 	}, nil
 }
 
@@ -0,0 +1,722 @@
+package v6
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/grype/grype/db/v5/pkg/resolver"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/pkg/qualifier"
+	"github.com/anchore/grype/grype/pkg/qualifier/platformcpe"
+	"github.com/anchore/grype/grype/pkg/qualifier/rpmmodularity"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/grype/internal/log"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+// cpeNamespaceProvider is the provider that all CPE-based vulnerability records are attributed to in the v5 namespace
+// scheme, which related vulnerability references (e.g. GHSA -> CVE) point to.
+const cpeNamespaceProvider = "nvd"
+
+var _ interface {
+	vulnerability.MetadataProvider
+	match.ExclusionProvider
+	io.Closer
+} = (*VulnerabilityProvider)(nil)
+
+// VulnerabilityProvider adapts a v6 store to the provider interfaces used by the matchers (searching by distro,
+// language, and CPE as well as fetching vulnerability metadata). Vulnerability namespaces are rendered in the v5
+// namespace format (e.g. "github:language:python" or "debian:distro:debian:12") so that existing matchers and
+// presenters can operate on the results without modification.
+type VulnerabilityProvider struct {
+	reader Reader
+}
+
+func NewVulnerabilityProvider(rdr Reader) *VulnerabilityProvider {
+	return &VulnerabilityProvider{
+		reader: rdr,
+	}
+}
+
+// Get returns all vulnerability records for the given vulnerability ID, optionally filtered to a single namespace.
+func (vp *VulnerabilityProvider) Get(id, namespace string) ([]vulnerability.Vulnerability, error) {
+	vulnSpec := VulnerabilitySpecifier{Name: id}
+	if provider := providerFromNamespace(namespace); provider != "" {
+		vulnSpec.Providers = []string{provider}
+	}
+
+	affectedPkgs, err := vp.reader.GetAffectedPackages(nil, &GetAffectedPackageOptions{
+		PreloadOS:            true,
+		PreloadPackage:       true,
+		PreloadVulnerability: true,
+		PreloadBlob:          true,
+		Vulnerabilities:      VulnerabilitySpecifiers{vulnSpec},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provider failed to fetch affected packages (id=%q): %w", id, err)
+	}
+
+	affectedCPEs, err := vp.reader.GetAffectedCPEs(nil, &GetAffectedCPEOptions{
+		PreloadCPE:           true,
+		PreloadVulnerability: true,
+		PreloadBlob:          true,
+		Vulnerabilities:      VulnerabilitySpecifiers{vulnSpec},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provider failed to fetch affected CPEs (id=%q): %w", id, err)
+	}
+
+	var results []vulnerability.Vulnerability
+	for _, a := range affectedPkgs {
+		results = append(results, vp.fromAffectedPackage(a, packageNamespace(a), version.UnknownFormat)...)
+	}
+	for _, a := range affectedCPEs {
+		results = append(results, vp.fromAffectedCPE(a)...)
+	}
+
+	if namespace == "" {
+		return results, nil
+	}
+
+	var filtered []vulnerability.Vulnerability
+	for _, r := range results {
+		if r.Namespace == namespace {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+func (vp *VulnerabilityProvider) GetByDistro(d *distro.Distro, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	osSpec := osSpecifierFromDistro(d)
+	affectedPkgs, err := vp.reader.GetAffectedPackages(&PackageSpecifier{Name: p.Name}, &GetAffectedPackageOptions{
+		PreloadOS:            true,
+		PreloadPackage:       true,
+		PreloadVulnerability: true,
+		PreloadBlob:          true,
+		OSs:                  OSSpecifiers{osSpec},
+	})
+	if err != nil {
+		if errors.Is(err, ErrDistroNotPresent) {
+			log.Debugf("no vulnerability data found in grype database for distro=%s package=%s", d.String(), p.Name)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("provider failed to search for vulnerabilities (distro=%q pkg=%q): %w", osSpec, p.Name, err)
+	}
+
+	vulns := make([]vulnerability.Vulnerability, 0)
+	for _, a := range affectedPkgs {
+		vulns = append(vulns, vp.fromAffectedPackage(a, packageNamespace(a), version.FormatFromPkg(p))...)
+	}
+	return vulns, nil
+}
+
+func (vp *VulnerabilityProvider) GetByLanguage(l syftPkg.Language, p pkg.Package) ([]vulnerability.Vulnerability, error) {
+	r, err := resolver.FromLanguage(l)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve package names for language=%q: %w", l, err)
+	}
+
+	vulns := make([]vulnerability.Vulnerability, 0)
+	for _, name := range r.Resolve(p) {
+		affectedPkgs, err := vp.reader.GetAffectedPackages(&PackageSpecifier{Name: name}, &GetAffectedPackageOptions{
+			PreloadPackage:       true,
+			PreloadVulnerability: true,
+			PreloadBlob:          true,
+			OSs:                  OSSpecifiers{NoOSSpecified},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("provider failed to fetch language=%q pkg=%q: %w", l, name, err)
+		}
+
+		for _, a := range affectedPkgs {
+			if a.Package == nil || !ecosystemMatchesLanguage(a.Package.Ecosystem, l) {
+				continue
+			}
+			vulns = append(vulns, vp.fromAffectedPackage(a, packageNamespace(a), version.FormatFromPkg(p))...)
+		}
+	}
+
+	return vulns, nil
+}
+
+func (vp *VulnerabilityProvider) GetByCPE(requestCPE cpe.CPE) ([]vulnerability.Vulnerability, error) {
+	if requestCPE.Attributes.Product == cpe.Any || requestCPE.Attributes.Product == "-" {
+		return nil, fmt.Errorf("product name is required")
+	}
+
+	affectedCPEs, err := vp.reader.GetAffectedCPEs(&cpe.Attributes{Product: requestCPE.Attributes.Product}, &GetAffectedCPEOptions{
+		PreloadCPE:           true,
+		PreloadVulnerability: true,
+		PreloadBlob:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provider failed to fetch product=%q: %w", requestCPE.Attributes.Product, err)
+	}
+
+	vulns := make([]vulnerability.Vulnerability, 0)
+	for _, a := range affectedCPEs {
+		if a.CPE == nil || !matchesWithoutVersion(requestCPE.Attributes, *a.CPE) {
+			continue
+		}
+		vulns = append(vulns, vp.fromAffectedCPE(a)...)
+	}
+	return vulns, nil
+}
+
+func (vp *VulnerabilityProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	return vp.GetMetadata(ref.ID, ref.Namespace)
+}
+
+func (vp *VulnerabilityProvider) GetMetadata(id, namespace string) (*vulnerability.Metadata, error) {
+	vulnSpec := VulnerabilitySpecifier{Name: id}
+	if provider := providerFromNamespace(namespace); provider != "" {
+		vulnSpec.Providers = []string{provider}
+	}
+
+	handles, err := vp.reader.GetVulnerabilities(&vulnSpec, &GetVulnerabilityOptions{Preload: true})
+	if err != nil {
+		return nil, fmt.Errorf("metadata provider failed to fetch id='%s' namespace='%s': %w", id, namespace, err)
+	}
+
+	if len(handles) == 0 {
+		return nil, nil
+	}
+
+	m := newMetadata(handles[0], namespace)
+
+	cves := relatedCVEs(handles[0])
+
+	m.EPSS, err = vp.epss(cves)
+	if err != nil {
+		return nil, fmt.Errorf("metadata provider failed to fetch EPSS data for id='%s': %w", id, err)
+	}
+
+	m.KnownExploited, err = vp.knownExploited(cves)
+	if err != nil {
+		return nil, fmt.Errorf("metadata provider failed to fetch KEV data for id='%s': %w", id, err)
+	}
+
+	return m, nil
+}
+
+// epss returns the most recent EPSS score for each of the given CVEs
+func (vp *VulnerabilityProvider) epss(cves []string) ([]vulnerability.EPSS, error) {
+	var out []vulnerability.EPSS
+	for _, cve := range cves {
+		records, err := vp.reader.GetEpss(cve)
+		if err != nil {
+			return nil, err
+		}
+		if len(records) == 0 {
+			continue
+		}
+		// records are ordered by date, most recent first
+		out = append(out, vulnerability.EPSS{
+			CVE:        records[0].Cve,
+			EPSS:       records[0].Epss,
+			Percentile: records[0].Percentile,
+			Date:       records[0].Date,
+		})
+	}
+	return out, nil
+}
+
+// knownExploited returns the CISA KEV catalog entries for any of the given CVEs
+func (vp *VulnerabilityProvider) knownExploited(cves []string) ([]vulnerability.KnownExploited, error) {
+	var out []vulnerability.KnownExploited
+	for _, cve := range cves {
+		records, err := vp.reader.GetKnownExploitedVulnerabilities(cve)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range records {
+			if r.BlobValue == nil {
+				continue
+			}
+			out = append(out, vulnerability.KnownExploited{
+				CVE:                        r.Cve,
+				VendorProject:              r.BlobValue.VendorProject,
+				Product:                    r.BlobValue.Product,
+				DateAdded:                  r.BlobValue.DateAdded,
+				RequiredAction:             r.BlobValue.RequiredAction,
+				DueDate:                    r.BlobValue.DueDate,
+				KnownRansomwareCampaignUse: r.BlobValue.KnownRansomwareCampaignUse,
+				Notes:                      r.BlobValue.Notes,
+				URLs:                       r.BlobValue.URLs,
+			})
+		}
+	}
+	return out, nil
+}
+
+// relatedCVEs returns the sorted set of CVEs that the given vulnerability record describes (the record itself if it
+// is a CVE, otherwise any CVE aliases).
+func relatedCVEs(vh VulnerabilityHandle) []string {
+	cves := strset.New()
+	if isCVE(vh.Name) {
+		cves.Add(strings.ToUpper(vh.Name))
+	}
+	if vh.BlobValue != nil {
+		for _, alias := range vh.BlobValue.Aliases {
+			if isCVE(alias) {
+				cves.Add(strings.ToUpper(alias))
+			}
+		}
+	}
+
+	out := cves.List()
+	sort.Strings(out)
+	return out
+}
+
+func isCVE(id string) bool {
+	return strings.HasPrefix(strings.ToUpper(id), "CVE-")
+}
+
+// GetRules returns the match exclusion rules for the given vulnerability, as ignore rules.
+func (vp *VulnerabilityProvider) GetRules(id string) ([]match.IgnoreRule, error) {
+	exclusions, err := vp.reader.GetMatchExclusions(id)
+	if err != nil {
+		return nil, fmt.Errorf("match exclusion provider failed to fetch records for vulnerability id='%s': %w", id, err)
+	}
+
+	var rules []match.IgnoreRule
+	for _, e := range exclusions {
+		rules = append(rules, ignoreRulesFromMatchExclusion(e)...)
+	}
+	return rules, nil
+}
+
+func ignoreRulesFromMatchExclusion(e MatchExclusionHandle) []match.IgnoreRule {
+	var reason string
+	var constraints []MatchExclusionConstraint
+	if e.BlobValue != nil {
+		reason = e.BlobValue.Justification
+		constraints = e.BlobValue.Constraints
+	}
+
+	if len(constraints) == 0 {
+		return []match.IgnoreRule{{Vulnerability: e.VulnerabilityID, Reason: reason}}
+	}
+
+	var rules []match.IgnoreRule
+	for _, c := range constraints {
+		rule := match.IgnoreRule{
+			Vulnerability: e.VulnerabilityID,
+			Reason:        reason,
+			Namespace:     c.Namespace,
+			FixState:      c.FixState,
+		}
+		if c.Package != nil {
+			rule.Package = match.IgnoreRulePackage{
+				Name:     c.Package.Name,
+				Language: c.Package.Language,
+				Type:     c.Package.Type,
+				Version:  c.Package.Version,
+				Location: c.Package.Location,
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func (vp *VulnerabilityProvider) Close() error {
+	if c, ok := vp.reader.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func (vp *VulnerabilityProvider) fromAffectedPackage(a AffectedPackageHandle, namespace string, fallbackFormat version.Format) []vulnerability.Vulnerability {
+	if a.Vulnerability == nil || a.BlobValue == nil {
+		return nil
+	}
+
+	var pkgName string
+	if a.Package != nil {
+		pkgName = a.Package.Name
+	}
+
+	v, err := newVulnerability(*a.Vulnerability, *a.BlobValue, pkgName, namespace, fallbackFormat)
+	if err != nil {
+		log.WithFields("namespace", namespace, "id", a.Vulnerability.Name, "error", err).Debug("failed to inflate vulnerability record (by package)")
+		return nil
+	}
+
+	return []vulnerability.Vulnerability{*v}
+}
+
+func (vp *VulnerabilityProvider) fromAffectedCPE(a AffectedCPEHandle) []vulnerability.Vulnerability {
+	if a.Vulnerability == nil || a.BlobValue == nil || a.CPE == nil {
+		return nil
+	}
+
+	namespace := fmt.Sprintf("%s:cpe", a.Vulnerability.ProviderID)
+	v, err := newVulnerability(*a.Vulnerability, *a.BlobValue, a.CPE.Product, namespace, version.UnknownFormat)
+	if err != nil {
+		log.WithFields("namespace", namespace, "id", a.Vulnerability.Name, "cpe", a.CPE.String(), "error", err).Debug("failed to inflate vulnerability record (by CPE)")
+		return nil
+	}
+
+	c, err := cpe.New(a.CPE.String(), cpe.NVDDictionaryLookupSource)
+	if err != nil {
+		log.WithFields("cpe", a.CPE.String(), "error", err).Debug("unable to parse CPE from vulnerability record")
+		return nil
+	}
+	v.CPEs = []cpe.CPE{c}
+
+	return []vulnerability.Vulnerability{*v}
+}
+
+func newVulnerability(vh VulnerabilityHandle, blob AffectedPackageBlob, pkgName, namespace string, fallbackFormat version.Format) (*vulnerability.Vulnerability, error) {
+	format := fallbackFormat
+	var constraints, fixVersions []string
+	fixState := vulnerability.FixStateUnknown
+	for _, r := range blob.Ranges {
+		if f := version.ParseFormat(r.Version.Type); f != version.UnknownFormat {
+			format = f
+		}
+		if r.Version.Constraint != "" {
+			constraints = append(constraints, r.Version.Constraint)
+		}
+		if r.Fix == nil {
+			continue
+		}
+		if r.Fix.Version != "" {
+			fixVersions = append(fixVersions, r.Fix.Version)
+		}
+		fixState = mergeFixState(fixState, r.Fix.State)
+	}
+
+	constraintStr := strings.Join(constraints, " || ")
+	constraint, err := version.GetConstraint(constraintStr, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse constraint='%s' format='%s': %w", constraintStr, format, err)
+	}
+
+	var qualifiers []qualifier.Qualifier
+	if blob.Qualifiers != nil {
+		if blob.Qualifiers.RpmModularity != "" {
+			qualifiers = append(qualifiers, rpmmodularity.New(blob.Qualifiers.RpmModularity))
+		}
+		for _, c := range blob.Qualifiers.PlatformCPEs {
+			qualifiers = append(qualifiers, platformcpe.New(c))
+		}
+	}
+
+	var advisories []vulnerability.Advisory
+	var aliases []string
+	if vh.BlobValue != nil {
+		aliases = vh.BlobValue.Aliases
+		for _, ref := range vh.BlobValue.References {
+			for _, tag := range NormalizeReferenceTags(ref.Tags) {
+				if tag == "advisory" {
+					advisories = append(advisories, vulnerability.Advisory{Link: ref.URL})
+					break
+				}
+			}
+		}
+	}
+
+	return &vulnerability.Vulnerability{
+		Reference: vulnerability.Reference{
+			ID:        vh.Name,
+			Namespace: namespace,
+		},
+		PackageName:       pkgName,
+		Constraint:        constraint,
+		PackageQualifiers: qualifiers,
+		CPEs:              make([]cpe.CPE, 0),
+		Fix: vulnerability.Fix{
+			Versions: fixVersions,
+			State:    fixState,
+		},
+		Advisories:             advisories,
+		RelatedVulnerabilities: relatedVulnerabilities(vh.Name, blob.CVEs, aliases),
+		Status:                 vulnerabilityStatus(vh),
+	}, nil
+}
+
+// vulnerabilityStatus returns the lifecycle status of the vulnerability record, where having been withdrawn takes
+// precedence over any status the record carries.
+func vulnerabilityStatus(vh VulnerabilityHandle) vulnerability.Status {
+	if vh.WithdrawnDate != nil {
+		return vulnerability.StatusWithdrawn
+	}
+	switch ParseVulnerabilityStatus(string(vh.Status)) {
+	case VulnerabilityAnalyzing:
+		return vulnerability.StatusAnalyzing
+	case VulnerabilityRejected:
+		return vulnerability.StatusRejected
+	case VulnerabilityDisputed:
+		return vulnerability.StatusDisputed
+	default:
+		return vulnerability.StatusActive
+	}
+}
+
+// mergeFixState combines fix states across multiple ranges, where a known fix always takes precedence.
+func mergeFixState(current vulnerability.FixState, status FixStatus) vulnerability.FixState {
+	var next vulnerability.FixState
+	switch status {
+	case FixedStatus:
+		next = vulnerability.FixStateFixed
+	case NotFixedStatus:
+		next = vulnerability.FixStateNotFixed
+	case WontFixStatus:
+		next = vulnerability.FixStateWontFix
+	default:
+		next = vulnerability.FixStateUnknown
+	}
+
+	if current == vulnerability.FixStateFixed || next == vulnerability.FixStateUnknown {
+		return current
+	}
+	return next
+}
+
+// relatedVulnerabilities returns references to the upstream CVE records (in the CPE namespace) for any CVE
+// identifiers that are not the record itself.
+func relatedVulnerabilities(name string, ids ...[]string) []vulnerability.Reference {
+	seen := map[string]struct{}{strings.ToLower(name): {}}
+	var refs []vulnerability.Reference
+	for _, set := range ids {
+		for _, id := range set {
+			key := strings.ToLower(id)
+			if _, ok := seen[key]; ok || !strings.HasPrefix(key, "cve-") {
+				continue
+			}
+			seen[key] = struct{}{}
+			refs = append(refs, vulnerability.Reference{
+				ID:        id,
+				Namespace: fmt.Sprintf("%s:cpe", cpeNamespaceProvider),
+			})
+		}
+	}
+	return refs
+}
+
+func newMetadata(vh VulnerabilityHandle, namespace string) *vulnerability.Metadata {
+	m := &vulnerability.Metadata{
+		ID:        vh.Name,
+		Namespace: namespace,
+		Severity:  vulnerability.UnknownSeverity.String(),
+	}
+
+	if vh.BlobValue == nil {
+		return m
+	}
+
+	m.Description = vh.BlobValue.Description
+	for _, ref := range vh.BlobValue.References {
+		m.URLs = append(m.URLs, ref.URL)
+	}
+	if len(m.URLs) > 0 {
+		m.DataSource = m.URLs[0]
+	}
+
+	severities := make([]Severity, len(vh.BlobValue.Severities))
+	copy(severities, vh.BlobValue.Severities)
+	sort.SliceStable(severities, func(i, j int) bool {
+		return severities[i].Rank < severities[j].Rank
+	})
+
+	var severity string
+	for _, sev := range severities {
+		switch v := sev.Value.(type) {
+		case CVSSSeverity:
+			m.Cvss = append(m.Cvss, vulnerability.Cvss{
+				Source:  sev.Source,
+				Type:    "Primary",
+				Version: v.Version,
+				Vector:  v.Vector,
+				Metrics: vulnerability.CvssMetrics{
+					BaseScore: v.Score,
+				},
+			})
+			if severity == "" {
+				severity = severityFromCVSSScore(v.Score)
+			}
+		case string:
+			if severity == "" {
+				severity = strings.ToLower(v)
+			}
+		}
+	}
+
+	if severity != "" {
+		m.Severity = severity
+	}
+
+	return m
+}
+
+// severityFromCVSSScore maps a CVSS base score onto the qualitative severity rating scale.
+func severityFromCVSSScore(score float64) string {
+	switch {
+	case score >= 9.0:
+		return vulnerability.CriticalSeverity.String()
+	case score >= 7.0:
+		return vulnerability.HighSeverity.String()
+	case score >= 4.0:
+		return vulnerability.MediumSeverity.String()
+	case score > 0:
+		return vulnerability.LowSeverity.String()
+	default:
+		return vulnerability.NegligibleSeverity.String()
+	}
+}
+
+// ecosystemMatchesLanguage indicates if records in the given package ecosystem are for the language a matcher is
+// searching by. This is an exact match on the language name (which covers ecosystems without a syft language, such as
+// "github-action" and "linux-kernel"), any ecosystem that syft maps to the language, or the hex ecosystem that is
+// shared by erlang and elixir.
+func ecosystemMatchesLanguage(ecosystem string, l syftPkg.Language) bool {
+	if strings.EqualFold(ecosystem, string(l)) {
+		return true
+	}
+	if el := syftPkg.LanguageByName(ecosystem); el != syftPkg.UnknownLanguage {
+		return el == l
+	}
+	return (l == syftPkg.Erlang || l == syftPkg.Elixir) && isBeamEcosystem(ecosystem)
+}
+
+// isBeamEcosystem indicates if the ecosystem is one that syft cannot attribute to a single language since packages
+// are shared between erlang and elixir (these are namespaced under erlang, which the hex matcher searches by).
+func isBeamEcosystem(ecosystem string) bool {
+	switch strings.ToLower(ecosystem) {
+	case "hex", "otp", "beam", "erlang", "elixir":
+		return true
+	}
+	return false
+}
+
+func packageNamespace(a AffectedPackageHandle) string {
+	var provider string
+	if a.Vulnerability != nil {
+		provider = a.Vulnerability.ProviderID
+	}
+
+	if a.OperatingSystem != nil {
+		return fmt.Sprintf("%s:distro:%s:%s", namespaceProvider(provider), distroTypeFromOSName(a.OperatingSystem.Name), a.OperatingSystem.Version())
+	}
+
+	var ecosystem string
+	if a.Package != nil {
+		ecosystem = a.Package.Ecosystem
+		if l := syftPkg.LanguageByName(ecosystem); l != syftPkg.UnknownLanguage {
+			ecosystem = string(l)
+		} else if isBeamEcosystem(ecosystem) {
+			ecosystem = string(syftPkg.Erlang)
+		}
+	}
+	return fmt.Sprintf("%s:language:%s", provider, ecosystem)
+}
+
+// namespaceProvider returns the v5 namespace provider for the given v6 provider ID (e.g. "redhat" for "rhel").
+func namespaceProvider(providerID string) string {
+	if p, ok := v5NamespaceProviders[providerID]; ok {
+		return p
+	}
+	return providerID
+}
+
+// v5NamespaceProviders maps the v6 provider IDs that differ from the provider component of v5 namespaces.
+var v5NamespaceProviders = map[string]string{
+	"rhel": "redhat",
+}
+
+// distroTypeFromOSName returns the grype distro type for the given os-release ID (e.g. "redhat" for "rhel"), which
+// is how v5 namespaces name the distro.
+func distroTypeFromOSName(name string) string {
+	if t, ok := distro.IDMapping[name]; ok {
+		return t.String()
+	}
+	return name
+}
+
+// providerFromNamespace returns the v6 provider ID for the given v5 namespace (e.g. "rhel" for "redhat:distro:redhat:8").
+func providerFromNamespace(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+	provider := strings.Split(namespace, ":")[0]
+	for id, p := range v5NamespaceProviders {
+		if p == provider {
+			return id
+		}
+	}
+	return provider
+}
+
+func osSpecifierFromDistro(d *distro.Distro) *OSSpecifier {
+	spec := &OSSpecifier{
+		Name: osNameFromDistro(d),
+	}
+
+	if d.Version == nil {
+		spec.LabelVersion = d.RawVersion
+		return spec
+	}
+
+	spec.MajorVersion = d.MajorVersion()
+	if parts := strings.Split(d.RawVersion, "."); len(parts) > 1 {
+		spec.MinorVersion = parts[1]
+	}
+	return spec
+}
+
+// osNameFromDistro returns the os-release ID for the given distro type (e.g. "rhel" for RedHat), which is how
+// operating systems are named within the DB.
+func osNameFromDistro(d *distro.Distro) string {
+	for id, t := range distro.IDMapping {
+		if t == d.Type && !strings.Contains(id, " ") {
+			return id
+		}
+	}
+	return d.Name()
+}
+
+// matchesWithoutVersion indicates if the requested CPE is satisfied by the CPE from the DB (ignoring version and
+// update fields), where any wildcard field on either side is considered a match.
+func matchesWithoutVersion(req cpe.Attributes, c Cpe) bool {
+	fields := [][2]string{
+		{req.Part, c.Part},
+		{req.Vendor, c.Vendor},
+		{req.Product, c.Product},
+		{req.Edition, c.Edition},
+		{req.Language, c.Language},
+		{req.SWEdition, c.SoftwareEdition},
+		{req.TargetSW, c.TargetSoftware},
+		{req.TargetHW, c.TargetHardware},
+		{req.Other, c.Other},
+	}
+	for _, f := range fields {
+		if isAnyCPEField(f[0]) || isAnyCPEField(f[1]) {
+			continue
+		}
+		if !strings.EqualFold(f[0], f[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAnyCPEField(v string) bool {
+	return v == cpe.Any || v == "*"
+}
@@ -0,0 +1,510 @@
+package v6
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v5 "github.com/anchore/grype/grype/db/v5"
+	v5Store "github.com/anchore/grype/grype/db/v5/store"
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func setupVulnerabilityProvider(t *testing.T) *VulnerabilityProvider {
+	t.Helper()
+	s := setupTestStore(t)
+
+	nvdVuln := &VulnerabilityHandle{
+		Name:     "CVE-2024-0001",
+		Provider: &Provider{ID: "nvd"},
+		BlobValue: &VulnerabilityBlob{
+			ID:          "CVE-2024-0001",
+			Description: "a bad thing",
+			References:  []Reference{{URL: "https://nvd.nist.gov/vuln/detail/CVE-2024-0001"}},
+			Severities: []Severity{
+				{
+					Scheme: SeveritySchemeCVSS,
+					Value:  CVSSSeverity{Vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", Version: "3.1", Score: 9.8},
+					Source: "nvd@nist.gov",
+				},
+			},
+		},
+	}
+	ghsaVuln := &VulnerabilityHandle{
+		Name:     "GHSA-abcd-efgh-ijkl",
+		Provider: &Provider{ID: "github"},
+		Status:   VulnerabilityDisputed,
+		BlobValue: &VulnerabilityBlob{
+			ID:         "GHSA-abcd-efgh-ijkl",
+			Aliases:    []string{"CVE-2024-0001"},
+			Severities: []Severity{{Scheme: SeveritySchemeHML, Value: "High"}},
+		},
+	}
+	debianVuln := &VulnerabilityHandle{
+		Name:      "CVE-2024-0001",
+		Provider:  &Provider{ID: "debian"},
+		BlobValue: &VulnerabilityBlob{ID: "CVE-2024-0001"},
+	}
+	require.NoError(t, s.AddVulnerabilities(nvdVuln, ghsaVuln, debianVuln))
+
+	require.NoError(t, s.AddAffectedPackages(
+		&AffectedPackageHandle{
+			VulnerabilityID: ghsaVuln.ID,
+			Package:         &Package{Name: "requests", Ecosystem: "python"},
+			BlobValue: &AffectedPackageBlob{
+				CVEs: []string{"CVE-2024-0001"},
+				Ranges: []AffectedRange{
+					{
+						Version: AffectedVersion{Type: "python", Constraint: ">=2.0,<2.5"},
+						Fix:     &Fix{Version: "2.5", State: FixedStatus},
+					},
+				},
+			},
+		},
+		&AffectedPackageHandle{
+			VulnerabilityID: debianVuln.ID,
+			OperatingSystem: &OperatingSystem{Name: "debian", MajorVersion: "12", Codename: "bookworm"},
+			Package:         &Package{Name: "curl", Ecosystem: "deb"},
+			BlobValue: &AffectedPackageBlob{
+				Ranges: []AffectedRange{
+					{
+						Version: AffectedVersion{Type: "dpkg", Constraint: "< 7.88.1-10+deb12u5"},
+						Fix:     &Fix{Version: "7.88.1-10+deb12u5", State: FixedStatus},
+					},
+				},
+			},
+		},
+	))
+
+	require.NoError(t, s.AddAffectedCPEs(&AffectedCPEHandle{
+		VulnerabilityID: nvdVuln.ID,
+		CPE:             &Cpe{Part: "a", Vendor: "haxx", Product: "curl"},
+		BlobValue: &AffectedPackageBlob{
+			Ranges: []AffectedRange{
+				{Version: AffectedVersion{Constraint: "< 8.0.0"}},
+			},
+		},
+	}))
+
+	require.NoError(t, s.AddEpss(&EpssHandle{
+		Cve:        "CVE-2024-0001",
+		Epss:       0.42,
+		Percentile: 0.97,
+		Date:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}))
+
+	kevAdded := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	kevDue := time.Date(2024, 2, 22, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, s.AddKnownExploitedVulnerabilities(&KnownExploitedVulnerabilityHandle{
+		Cve: "CVE-2024-0001",
+		BlobValue: &KnownExploitedVulnerabilityBlob{
+			Cve:            "CVE-2024-0001",
+			VendorProject:  "haxx",
+			Product:        "curl",
+			DateAdded:      &kevAdded,
+			RequiredAction: "Apply mitigations per vendor instructions or discontinue use of the product if mitigations are unavailable.",
+			DueDate:        &kevDue,
+		},
+	}))
+
+	require.NoError(t, s.AddMatchExclusions(&MatchExclusionHandle{
+		VulnerabilityID: "CVE-2024-0001",
+		BlobValue: &MatchExclusionBlob{
+			Justification: "only affects the curl command line tool on Windows",
+			Constraints: []MatchExclusionConstraint{
+				{
+					Namespace: "nvd:cpe",
+					Package:   &MatchExclusionPackage{Name: "curl", Type: "deb"},
+				},
+			},
+		},
+	}))
+
+	return NewVulnerabilityProvider(s)
+}
+
+func TestVulnerabilityProvider_GetByLanguage(t *testing.T) {
+	vp := setupVulnerabilityProvider(t)
+
+	vulns, err := vp.GetByLanguage(syftPkg.Python, pkg.Package{Name: "requests", Version: "2.1", Type: syftPkg.PythonPkg, Language: syftPkg.Python})
+	require.NoError(t, err)
+	require.Len(t, vulns, 1)
+
+	v := vulns[0]
+	assert.Equal(t, "GHSA-abcd-efgh-ijkl", v.ID)
+	assert.Equal(t, "github:language:python", v.Namespace)
+	assert.Equal(t, "requests", v.PackageName)
+	assert.Equal(t, vulnerability.Fix{Versions: []string{"2.5"}, State: vulnerability.FixStateFixed}, v.Fix)
+	assert.Equal(t, []vulnerability.Reference{{ID: "CVE-2024-0001", Namespace: "nvd:cpe"}}, v.RelatedVulnerabilities)
+	assert.Equal(t, vulnerability.StatusDisputed, v.Status)
+
+	ver, err := version.NewVersion("2.1", version.PythonFormat)
+	require.NoError(t, err)
+	satisfied, err := v.Constraint.Satisfied(ver)
+	require.NoError(t, err)
+	assert.True(t, satisfied)
+}
+
+func TestVulnerabilityStatus(t *testing.T) {
+	withdrawn := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		handle   VulnerabilityHandle
+		expected vulnerability.Status
+	}{
+		{
+			name:     "no status",
+			expected: vulnerability.StatusActive,
+		},
+		{
+			name:     "analyzing",
+			handle:   VulnerabilityHandle{Status: VulnerabilityAnalyzing},
+			expected: vulnerability.StatusAnalyzing,
+		},
+		{
+			name:     "rejected",
+			handle:   VulnerabilityHandle{Status: VulnerabilityRejected},
+			expected: vulnerability.StatusRejected,
+		},
+		{
+			name:     "withdrawn takes precedence",
+			handle:   VulnerabilityHandle{Status: VulnerabilityActive, WithdrawnDate: &withdrawn},
+			expected: vulnerability.StatusWithdrawn,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, vulnerabilityStatus(test.handle))
+		})
+	}
+}
+
+func TestVulnerabilityProvider_GetByDistro(t *testing.T) {
+	vp := setupVulnerabilityProvider(t)
+
+	d, err := distro.New(distro.Debian, "12")
+	require.NoError(t, err)
+
+	vulns, err := vp.GetByDistro(d, pkg.Package{Name: "curl", Version: "7.88.1-10", Type: syftPkg.DebPkg})
+	require.NoError(t, err)
+	require.Len(t, vulns, 1)
+	assert.Equal(t, "CVE-2024-0001", vulns[0].ID)
+	assert.Equal(t, "debian:distro:debian:12", vulns[0].Namespace)
+	assert.Equal(t, "< 7.88.1-10+deb12u5 (deb)", vulns[0].Constraint.String())
+
+	// distros without any data should not result in an error
+	d, err = distro.New(distro.Ubuntu, "22.04")
+	require.NoError(t, err)
+
+	vulns, err = vp.GetByDistro(d, pkg.Package{Name: "curl", Version: "7.88.1-10", Type: syftPkg.DebPkg})
+	require.NoError(t, err)
+	assert.Empty(t, vulns)
+}
+
+func TestVulnerabilityProvider_GetByDistro_MatchesV5Namespaces(t *testing.T) {
+	tests := []struct {
+		name       string
+		providerID string
+		os         *OperatingSystem
+		v5Vuln     v5.Vulnerability
+		distro     distro.Type
+		version    string
+		pkgType    syftPkg.Type
+		constraint string
+		format     string
+	}{
+		{
+			name:       "rhel",
+			providerID: "rhel",
+			os:         &OperatingSystem{Name: "rhel", MajorVersion: "8"},
+			distro:     distro.RedHat,
+			version:    "8.9",
+			pkgType:    syftPkg.RpmPkg,
+			constraint: "< 0:7.61.1-34.el8",
+			format:     "rpm",
+			v5Vuln:     v5.Vulnerability{Namespace: "redhat:distro:redhat:8", VersionFormat: "rpm"},
+		},
+		{
+			name:       "amazon linux",
+			providerID: "amazon",
+			os:         &OperatingSystem{Name: "amzn", MajorVersion: "2"},
+			distro:     distro.AmazonLinux,
+			version:    "2",
+			pkgType:    syftPkg.RpmPkg,
+			constraint: "< 0:7.79.1-1.amzn2.0.1",
+			format:     "rpm",
+			v5Vuln:     v5.Vulnerability{Namespace: "amazon:distro:amazonlinux:2", VersionFormat: "rpm"},
+		},
+		{
+			name:       "oracle linux",
+			providerID: "oracle",
+			os:         &OperatingSystem{Name: "ol", MajorVersion: "8"},
+			distro:     distro.OracleLinux,
+			version:    "8",
+			pkgType:    syftPkg.RpmPkg,
+			constraint: "< 0:7.61.1-34.el8",
+			format:     "rpm",
+			v5Vuln:     v5.Vulnerability{Namespace: "oracle:distro:oraclelinux:8", VersionFormat: "rpm"},
+		},
+		{
+			name:       "ubuntu",
+			providerID: "ubuntu",
+			os:         &OperatingSystem{Name: "ubuntu", MajorVersion: "22", MinorVersion: "04"},
+			distro:     distro.Ubuntu,
+			version:    "22.04",
+			pkgType:    syftPkg.DebPkg,
+			constraint: "< 7.81.0-1ubuntu1.15",
+			format:     "dpkg",
+			v5Vuln:     v5.Vulnerability{Namespace: "ubuntu:distro:ubuntu:22.04", VersionFormat: "deb"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := setupTestStore(t)
+			vh := &VulnerabilityHandle{
+				Name:      "CVE-2024-0001",
+				Provider:  &Provider{ID: test.providerID},
+				BlobValue: &VulnerabilityBlob{ID: "CVE-2024-0001"},
+			}
+			require.NoError(t, s.AddVulnerabilities(vh))
+			require.NoError(t, s.AddAffectedPackages(&AffectedPackageHandle{
+				VulnerabilityID: vh.ID,
+				OperatingSystem: test.os,
+				Package:         &Package{Name: "curl", Ecosystem: test.format},
+				BlobValue: &AffectedPackageBlob{
+					Ranges: []AffectedRange{{Version: AffectedVersion{Type: test.format, Constraint: test.constraint}}},
+				},
+			}))
+
+			legacyStore, err := v5Store.New(t.TempDir(), true)
+			require.NoError(t, err)
+			t.Cleanup(func() { _ = legacyStore.Close() })
+			v5Vuln := test.v5Vuln
+			v5Vuln.ID = "CVE-2024-0001"
+			v5Vuln.PackageName = "curl"
+			v5Vuln.VersionConstraint = test.constraint
+			require.NoError(t, legacyStore.AddVulnerability(v5Vuln))
+			require.NoError(t, legacyStore.AddVulnerabilityMetadata(v5.VulnerabilityMetadata{ID: v5Vuln.ID, Namespace: v5Vuln.Namespace}))
+			v5Provider, err := v5.NewVulnerabilityProvider(legacyStore)
+			require.NoError(t, err)
+
+			d, err := distro.New(test.distro, test.version)
+			require.NoError(t, err)
+			p := pkg.Package{Name: "curl", Version: "7.0.0", Type: test.pkgType}
+
+			expected, err := v5Provider.GetByDistro(d, p)
+			require.NoError(t, err)
+			require.Len(t, expected, 1)
+
+			actual, err := NewVulnerabilityProvider(s).GetByDistro(d, p)
+			require.NoError(t, err)
+			require.Len(t, actual, 1)
+
+			assert.Equal(t, expected[0].Namespace, actual[0].Namespace)
+
+			// the namespace must also resolve back to the record when fetched directly
+			byID, err := NewVulnerabilityProvider(s).Get("CVE-2024-0001", actual[0].Namespace)
+			require.NoError(t, err)
+			assert.Len(t, byID, 1)
+		})
+	}
+}
+
+func TestVulnerabilityProvider_GetByCPE(t *testing.T) {
+	vp := setupVulnerabilityProvider(t)
+
+	vulns, err := vp.GetByCPE(cpe.Must("cpe:2.3:a:haxx:curl:7.0.0:*:*:*:*:*:*:*", ""))
+	require.NoError(t, err)
+	require.Len(t, vulns, 1)
+	assert.Equal(t, "CVE-2024-0001", vulns[0].ID)
+	assert.Equal(t, "nvd:cpe", vulns[0].Namespace)
+	require.Len(t, vulns[0].CPEs, 1)
+	assert.Equal(t, "curl", vulns[0].CPEs[0].Attributes.Product)
+
+	vulns, err = vp.GetByCPE(cpe.Must("cpe:2.3:a:other:curl:7.0.0:*:*:*:*:*:*:*", ""))
+	require.NoError(t, err)
+	assert.Empty(t, vulns)
+}
+
+func TestVulnerabilityProvider_GetMetadata(t *testing.T) {
+	vp := setupVulnerabilityProvider(t)
+
+	m, err := vp.GetMetadata("CVE-2024-0001", "nvd:cpe")
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, "critical", m.Severity)
+	assert.Equal(t, "a bad thing", m.Description)
+	assert.Equal(t, "https://nvd.nist.gov/vuln/detail/CVE-2024-0001", m.DataSource)
+	require.Len(t, m.Cvss, 1)
+	assert.Equal(t, 9.8, m.Cvss[0].Metrics.BaseScore)
+	expectedEPSS := []vulnerability.EPSS{
+		{
+			CVE:        "CVE-2024-0001",
+			EPSS:       0.42,
+			Percentile: 0.97,
+			Date:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	assert.Equal(t, expectedEPSS, m.EPSS)
+	require.Len(t, m.KnownExploited, 1)
+	kev := m.KnownExploited[0]
+	assert.Equal(t, "CVE-2024-0001", kev.CVE)
+	assert.Equal(t, "curl", kev.Product)
+	require.NotNil(t, kev.DateAdded)
+	assert.True(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).Equal(*kev.DateAdded))
+	require.NotNil(t, kev.DueDate)
+	assert.True(t, time.Date(2024, 2, 22, 0, 0, 0, 0, time.UTC).Equal(*kev.DueDate))
+
+	m, err = vp.GetMetadata("GHSA-abcd-efgh-ijkl", "github:language:python")
+	require.NoError(t, err)
+	require.NotNil(t, m)
+	assert.Equal(t, "high", m.Severity)
+	// EPSS and KEV data is found by the CVE alias
+	assert.Equal(t, expectedEPSS, m.EPSS)
+	assert.Len(t, m.KnownExploited, 1)
+
+	m, err = vp.GetMetadata("CVE-0000-0000", "nvd:cpe")
+	require.NoError(t, err)
+	assert.Nil(t, m)
+}
+
+func TestVulnerabilityProvider_GetRules(t *testing.T) {
+	vp := setupVulnerabilityProvider(t)
+
+	rules, err := vp.GetRules("cve-2024-0001")
+	require.NoError(t, err)
+	expected := []match.IgnoreRule{
+		{
+			Vulnerability: "CVE-2024-0001",
+			Reason:        "only affects the curl command line tool on Windows",
+			Namespace:     "nvd:cpe",
+			Package:       match.IgnoreRulePackage{Name: "curl", Type: "deb"},
+		},
+	}
+	assert.Equal(t, expected, rules)
+
+	rules, err = vp.GetRules("GHSA-abcd-efgh-ijkl")
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+
+	// the rules are applied to matches by the DB-backed exclusion provider
+	curl := pkg.Package{ID: "curl-id", Name: "curl", Version: "7.88.1-10", Type: syftPkg.DebPkg}
+	cpeMatch := match.Match{
+		Vulnerability: vulnerability.Vulnerability{Reference: vulnerability.Reference{ID: "CVE-2024-0001", Namespace: "nvd:cpe"}},
+		Package:       curl,
+	}
+	remaining, ignored := match.ApplyExplicitIgnoreRules(vp, match.NewMatches(cpeMatch))
+	assert.Zero(t, remaining.Count())
+	require.Len(t, ignored, 1)
+	assert.Equal(t, expected, ignored[0].AppliedIgnoreRules)
+}
+
+func TestIgnoreRulesFromMatchExclusion(t *testing.T) {
+	// an exclusion without constraints excludes all matches for the vulnerability
+	assert.Equal(t, []match.IgnoreRule{{Vulnerability: "CVE-2024-0002"}}, ignoreRulesFromMatchExclusion(MatchExclusionHandle{VulnerabilityID: "CVE-2024-0002"}))
+
+	// each constraint is a separate rule
+	rules := ignoreRulesFromMatchExclusion(MatchExclusionHandle{
+		VulnerabilityID: "CVE-2024-0002",
+		BlobValue: &MatchExclusionBlob{
+			Constraints: []MatchExclusionConstraint{
+				{FixState: "not-fixed", Package: &MatchExclusionPackage{Language: "python"}},
+				{Namespace: "github:language:java"},
+			},
+		},
+	})
+	assert.Equal(t, []match.IgnoreRule{
+		{Vulnerability: "CVE-2024-0002", FixState: "not-fixed", Package: match.IgnoreRulePackage{Language: "python"}},
+		{Vulnerability: "CVE-2024-0002", Namespace: "github:language:java"},
+	}, rules)
+}
+
+func TestVulnerabilityProvider_GetByLanguage_Ecosystems(t *testing.T) {
+	tests := []struct {
+		name              string
+		ecosystem         string
+		language          syftPkg.Language
+		pkgType           syftPkg.Type
+		expectedNamespace string
+	}{
+		{
+			name:              "erlang package in the hex ecosystem",
+			ecosystem:         "hex",
+			language:          syftPkg.Erlang,
+			pkgType:           syftPkg.HexPkg,
+			expectedNamespace: "github:language:erlang",
+		},
+		{
+			name:              "elixir package in the hex ecosystem",
+			ecosystem:         "hex",
+			language:          syftPkg.Elixir,
+			pkgType:           syftPkg.HexPkg,
+			expectedNamespace: "github:language:erlang",
+		},
+		{
+			name:              "erlang ecosystem",
+			ecosystem:         "erlang",
+			language:          syftPkg.Erlang,
+			pkgType:           syftPkg.HexPkg,
+			expectedNamespace: "github:language:erlang",
+		},
+		{
+			name:              "github action",
+			ecosystem:         "github-action",
+			language:          "github-action",
+			pkgType:           syftPkg.GithubActionPkg,
+			expectedNamespace: "github:language:github-action",
+		},
+		{
+			name:              "linux kernel",
+			ecosystem:         "linux-kernel",
+			language:          "linux-kernel",
+			pkgType:           syftPkg.LinuxKernelPkg,
+			expectedNamespace: "github:language:linux-kernel",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := setupTestStore(t)
+			vh := &VulnerabilityHandle{
+				Name:      "GHSA-abcd-efgh-ijkl",
+				Provider:  &Provider{ID: "github"},
+				BlobValue: &VulnerabilityBlob{ID: "GHSA-abcd-efgh-ijkl"},
+			}
+			require.NoError(t, s.AddVulnerabilities(vh))
+			require.NoError(t, s.AddAffectedPackages(&AffectedPackageHandle{
+				VulnerabilityID: vh.ID,
+				Package:         &Package{Name: "thing", Ecosystem: test.ecosystem},
+				BlobValue: &AffectedPackageBlob{
+					Ranges: []AffectedRange{{Version: AffectedVersion{Constraint: "< 2.0.0"}}},
+				},
+			}))
+			vp := NewVulnerabilityProvider(s)
+
+			p := pkg.Package{Name: "thing", Version: "1.0.0", Type: test.pkgType, Language: test.language}
+			vulns, err := vp.GetByLanguage(test.language, p)
+			require.NoError(t, err)
+			require.Len(t, vulns, 1)
+			assert.Equal(t, "GHSA-abcd-efgh-ijkl", vulns[0].ID)
+			assert.Equal(t, test.expectedNamespace, vulns[0].Namespace)
+
+			// the namespace must also resolve back to the record when fetched directly
+			byID, err := vp.Get(vh.Name, vulns[0].Namespace)
+			require.NoError(t, err)
+			assert.Len(t, byID, 1)
+
+			// records in the ecosystem must not be returned for unrelated languages
+			vulns, err = vp.GetByLanguage(syftPkg.Python, p)
+			require.NoError(t, err)
+			assert.Empty(t, vulns)
+		})
+	}
+}
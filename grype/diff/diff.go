@@ -0,0 +1,282 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+// The kinds of changes that can be found between two findings for the same vulnerability and package
+const (
+	SeverityChange       = "severity"
+	FixAvailableChange   = "fix-available"
+	FixChange            = "fix"
+	PackageVersionChange = "package-version"
+)
+
+// Finding is a summary of a single match from a grype JSON report
+type Finding struct {
+	Vulnerability  string   `json:"vulnerability"`
+	Namespace      string   `json:"namespace"`
+	Severity       string   `json:"severity"`
+	FixState       string   `json:"fixState"`
+	FixVersions    []string `json:"fixVersions"`
+	PackageName    string   `json:"packageName"`
+	PackageVersion string   `json:"packageVersion"`
+	PackageType    string   `json:"packageType"`
+	Locations      []string `json:"-"`
+}
+
+// ChangedFinding is a finding that is in both reports but has changed
+type ChangedFinding struct {
+	Before  Finding  `json:"before"`
+	After   Finding  `json:"after"`
+	Changes []string `json:"changes"`
+}
+
+// Diff is the set of differences between the findings of two grype JSON reports
+type Diff struct {
+	Added   []Finding        `json:"added"`
+	Removed []Finding        `json:"removed"`
+	Changed []ChangedFinding `json:"changed"`
+}
+
+// IsEmpty indicates if there are no differences between the reports
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// ReadDocument reads a grype JSON report from the given path
+func ReadDocument(path string) (*models.Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open report: %w", err)
+	}
+	defer f.Close()
+
+	var doc models.Document
+	if err := json.NewDecoder(f).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode %q (expected a grype JSON report): %w", path, err)
+	}
+	return &doc, nil
+}
+
+// findingKey identifies the "same" finding across two reports. The package version is not part of the key so that
+// version bumps are reported as a change (and not as a removed and an added finding).
+type findingKey struct {
+	vulnerability string
+	packageName   string
+	packageType   string
+}
+
+// Compare returns the differences between the findings of the base and target reports.
+func Compare(base, target models.Document) Diff {
+	baseFindings := groupFindings(base.Matches)
+	targetFindings := groupFindings(target.Matches)
+
+	d := Diff{
+		Added:   []Finding{},
+		Removed: []Finding{},
+		Changed: []ChangedFinding{},
+	}
+
+	for key, before := range baseFindings {
+		after := targetFindings[key]
+
+		// the same vulnerability may be found for several instances of a package (e.g. at different locations),
+		// which are paired up in location order
+		for i := range before {
+			if i >= len(after) {
+				d.Removed = append(d.Removed, before[i])
+				continue
+			}
+			if changes := compareFindings(before[i], after[i]); len(changes) > 0 {
+				d.Changed = append(d.Changed, ChangedFinding{Before: before[i], After: after[i], Changes: changes})
+			}
+		}
+	}
+
+	for key, after := range targetFindings {
+		before := baseFindings[key]
+		if len(after) > len(before) {
+			d.Added = append(d.Added, after[len(before):]...)
+		}
+	}
+
+	sortFindings(d.Added)
+	sortFindings(d.Removed)
+	sort.SliceStable(d.Changed, func(i, j int) bool {
+		return lessFinding(d.Changed[i].After, d.Changed[j].After)
+	})
+
+	return d
+}
+
+func groupFindings(matches []models.Match) map[findingKey][]Finding {
+	out := make(map[findingKey][]Finding)
+	for _, m := range matches {
+		f := newFinding(m)
+		key := findingKey{
+			vulnerability: f.Vulnerability,
+			packageName:   f.PackageName,
+			packageType:   f.PackageType,
+		}
+		out[key] = append(out[key], f)
+	}
+	for key := range out {
+		sortFindings(out[key])
+	}
+	return out
+}
+
+func newFinding(m models.Match) Finding {
+	var locations []string
+	for _, l := range m.Artifact.Locations {
+		locations = append(locations, l.RealPath)
+	}
+	sort.Strings(locations)
+
+	fixVersions := m.Vulnerability.Fix.Versions
+	if fixVersions == nil {
+		fixVersions = []string{}
+	}
+
+	return Finding{
+		Vulnerability:  m.Vulnerability.ID,
+		Namespace:      m.Vulnerability.Namespace,
+		Severity:       m.Vulnerability.Severity,
+		FixState:       m.Vulnerability.Fix.State,
+		FixVersions:    fixVersions,
+		PackageName:    m.Artifact.Name,
+		PackageVersion: m.Artifact.Version,
+		PackageType:    string(m.Artifact.Type),
+		Locations:      locations,
+	}
+}
+
+func compareFindings(before, after Finding) []string {
+	var changes []string
+
+	if !strings.EqualFold(before.Severity, after.Severity) {
+		changes = append(changes, SeverityChange)
+	}
+
+	if before.FixState != after.FixState || !slices.Equal(before.FixVersions, after.FixVersions) {
+		if before.FixState != string(vulnerability.FixStateFixed) && after.FixState == string(vulnerability.FixStateFixed) {
+			changes = append(changes, FixAvailableChange)
+		} else {
+			changes = append(changes, FixChange)
+		}
+	}
+
+	if before.PackageVersion != after.PackageVersion {
+		changes = append(changes, PackageVersionChange)
+	}
+
+	return changes
+}
+
+func sortFindings(findings []Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		return lessFinding(findings[i], findings[j])
+	})
+}
+
+func lessFinding(a, b Finding) bool {
+	if a.PackageName != b.PackageName {
+		return a.PackageName < b.PackageName
+	}
+	if a.PackageType != b.PackageType {
+		return a.PackageType < b.PackageType
+	}
+	if a.Vulnerability != b.Vulnerability {
+		return a.Vulnerability < b.Vulnerability
+	}
+	if a.PackageVersion != b.PackageVersion {
+		return a.PackageVersion < b.PackageVersion
+	}
+	return strings.Join(a.Locations, ",") < strings.Join(b.Locations, ",")
+}
+
+// Present writes the differences in the given output format (table or json)
+func Present(outputFormat string, d Diff, output io.Writer) error {
+	switch outputFormat {
+	case "table":
+		if d.IsEmpty() {
+			_, err := io.WriteString(output, "No differences found\n")
+			return err
+		}
+
+		var rows [][]string
+		for _, f := range d.Added {
+			rows = append(rows, []string{"added", f.PackageName, f.PackageVersion, strings.Join(f.FixVersions, ", "), f.PackageType, f.Vulnerability, f.Severity})
+		}
+		for _, f := range d.Removed {
+			rows = append(rows, []string{"removed", f.PackageName, f.PackageVersion, strings.Join(f.FixVersions, ", "), f.PackageType, f.Vulnerability, f.Severity})
+		}
+		for _, c := range d.Changed {
+			rows = append(rows, []string{
+				"changed (" + strings.Join(c.Changes, ", ") + ")",
+				c.After.PackageName,
+				transition(c.Before.PackageVersion, c.After.PackageVersion),
+				transition(strings.Join(c.Before.FixVersions, ", "), strings.Join(c.After.FixVersions, ", ")),
+				c.After.PackageType,
+				c.After.Vulnerability,
+				transition(c.Before.Severity, c.After.Severity),
+			})
+		}
+
+		table := tablewriter.NewWriter(output)
+		columns := []string{"Change", "Name", "Installed", "Fixed-In", "Type", "Vulnerability", "Severity"}
+
+		table.SetHeader(columns)
+		table.SetAutoWrapText(false)
+		table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+
+		table.SetHeaderLine(false)
+		table.SetBorder(false)
+		table.SetAutoFormatHeaders(true)
+		table.SetCenterSeparator("")
+		table.SetColumnSeparator("")
+		table.SetRowSeparator("")
+		table.SetTablePadding("  ")
+		table.SetNoWhiteSpace(true)
+
+		table.AppendBulk(rows)
+		table.Render()
+	case "json":
+		enc := json.NewEncoder(output)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", " ")
+		if err := enc.Encode(d); err != nil {
+			return fmt.Errorf("failed to encode diff information: %+v", err)
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %s", outputFormat)
+	}
+	return nil
+}
+
+// transition describes a value that may have changed (e.g. "1.0 -> 1.1")
+func transition(before, after string) string {
+	if before == after {
+		return after
+	}
+	if before == "" {
+		before = "(none)"
+	}
+	if after == "" {
+		after = "(none)"
+	}
+	return before + " -> " + after
+}
@@ -0,0 +1,140 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/syft/syft/file"
+	syftPkg "github.com/anchore/syft/syft/pkg"
+)
+
+func newMatch(vulnID, severity, name, version string, fixes ...string) models.Match {
+	m := models.Match{
+		Artifact: models.Package{
+			Name:      name,
+			Version:   version,
+			Type:      syftPkg.NpmPkg,
+			Locations: []file.Coordinates{{RealPath: "/app/node_modules/" + name + "/package.json"}},
+		},
+	}
+	m.Vulnerability.ID = vulnID
+	m.Vulnerability.Severity = severity
+	m.Vulnerability.Fix.State = "not-fixed"
+	if len(fixes) > 0 {
+		m.Vulnerability.Fix.State = "fixed"
+		m.Vulnerability.Fix.Versions = fixes
+	}
+	return m
+}
+
+func TestCompare(t *testing.T) {
+	base := models.Document{
+		Matches: []models.Match{
+			newMatch("CVE-2024-0001", "High", "lodash", "4.17.20", "4.17.21"),    // unchanged
+			newMatch("CVE-2024-0002", "Medium", "lodash", "4.17.20", "4.17.21"),  // severity change
+			newMatch("CVE-2024-0003", "High", "minimist", "1.2.5"),               // fix is now available
+			newMatch("CVE-2024-0004", "Critical", "express", "4.17.0", "4.17.3"), // package version bump
+			newMatch("CVE-2024-0005", "Low", "qs", "6.5.2", "6.5.3"),             // removed
+		},
+	}
+	target := models.Document{
+		Matches: []models.Match{
+			newMatch("CVE-2024-0001", "High", "lodash", "4.17.20", "4.17.21"),
+			newMatch("CVE-2024-0002", "High", "lodash", "4.17.20", "4.17.21"),
+			newMatch("CVE-2024-0003", "High", "minimist", "1.2.5", "1.2.6"),
+			newMatch("CVE-2024-0004", "Critical", "express", "4.17.1", "4.17.3"),
+			newMatch("CVE-2024-0006", "Medium", "axios", "0.21.0", "0.21.1"), // added
+		},
+	}
+
+	d := Compare(base, target)
+
+	require.Len(t, d.Added, 1)
+	assert.Equal(t, "CVE-2024-0006", d.Added[0].Vulnerability)
+
+	require.Len(t, d.Removed, 1)
+	assert.Equal(t, "CVE-2024-0005", d.Removed[0].Vulnerability)
+
+	var changes []string
+	for _, c := range d.Changed {
+		changes = append(changes, c.After.Vulnerability+": "+c.Changes[0])
+		assert.Len(t, c.Changes, 1)
+	}
+	assert.Equal(t, []string{
+		"CVE-2024-0004: " + PackageVersionChange,
+		"CVE-2024-0002: " + SeverityChange,
+		"CVE-2024-0003: " + FixAvailableChange,
+	}, changes)
+
+	assert.True(t, Compare(base, base).IsEmpty())
+}
+
+func TestCompare_MultipleInstances(t *testing.T) {
+	first := newMatch("CVE-2024-0001", "High", "lodash", "4.17.20")
+	second := newMatch("CVE-2024-0001", "High", "lodash", "4.17.20")
+	second.Artifact.Locations = []file.Coordinates{{RealPath: "/other/node_modules/lodash/package.json"}}
+
+	d := Compare(models.Document{Matches: []models.Match{first}}, models.Document{Matches: []models.Match{second, first}})
+
+	assert.Empty(t, d.Removed)
+	assert.Empty(t, d.Changed)
+	require.Len(t, d.Added, 1)
+	assert.Equal(t, []string{"/other/node_modules/lodash/package.json"}, d.Added[0].Locations)
+}
+
+func TestPresent(t *testing.T) {
+	d := Compare(
+		models.Document{Matches: []models.Match{
+			newMatch("CVE-2024-0002", "Medium", "lodash", "4.17.20"),
+			newMatch("CVE-2024-0005", "Low", "qs", "6.5.2", "6.5.3"),
+		}},
+		models.Document{Matches: []models.Match{
+			newMatch("CVE-2024-0002", "High", "lodash", "4.17.21", "4.17.22"),
+			newMatch("CVE-2024-0006", "Medium", "axios", "0.21.0", "0.21.1"),
+		}},
+	)
+
+	var table bytes.Buffer
+	require.NoError(t, Present("table", d, &table))
+	expected := "CHANGE                                              NAME    INSTALLED           FIXED-IN           TYPE  VULNERABILITY  SEVERITY       \n" +
+		"added                                               axios   0.21.0              0.21.1             npm   CVE-2024-0006  Medium          \n" +
+		"removed                                             qs      6.5.2               6.5.3              npm   CVE-2024-0005  Low             \n" +
+		"changed (severity, fix-available, package-version)  lodash  4.17.20 -> 4.17.21  (none) -> 4.17.22  npm   CVE-2024-0002  Medium -> High  \n"
+	assert.Equal(t, expected, table.String())
+
+	var out bytes.Buffer
+	require.NoError(t, Present("json", d, &out))
+	var decoded Diff
+	require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+	assert.Len(t, decoded.Added, 1)
+	assert.Len(t, decoded.Removed, 1)
+	require.Len(t, decoded.Changed, 1)
+	assert.Equal(t, []string{SeverityChange, FixAvailableChange, PackageVersionChange}, decoded.Changed[0].Changes)
+
+	var empty bytes.Buffer
+	require.NoError(t, Present("table", Diff{}, &empty))
+	assert.Equal(t, "No differences found\n", empty.String())
+
+	assert.Error(t, Present("sarif", d, &empty))
+}
+
+func TestReadDocument(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.json")
+	contents, err := json.Marshal(models.Document{Matches: []models.Match{newMatch("CVE-2024-0001", "High", "lodash", "4.17.20")}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, contents, 0600))
+
+	doc, err := ReadDocument(path)
+	require.NoError(t, err)
+	require.Len(t, doc.Matches, 1)
+
+	_, err = ReadDocument(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -100,10 +100,6 @@ func (d Distro) IsRolling() bool {
 
 // Unsupported Linux distributions
 func (d Distro) Disabled() bool {
-	switch {
-	case d.Type == ArchLinux:
-		return true
-	default:
-		return false
-	}
+	// there are currently no unsupported distributions
+	return false
 }
@@ -3,4 +3,10 @@ package grypeerr
 var (
 	// ErrAboveSeverityThreshold indicates when a vulnerability severity is discovered that is above the given --fail-on severity value
 	ErrAboveSeverityThreshold = NewExpectedErr("discovered vulnerabilities at or above the severity threshold")
+
+	// ErrAboveEPSSThreshold indicates when a vulnerability is discovered with an EPSS percentile at or above the given --fail-on-epss value
+	ErrAboveEPSSThreshold = NewExpectedErr("discovered vulnerabilities at or above the EPSS percentile threshold")
+
+	// ErrKnownExploitedVulnerability indicates when a vulnerability in the CISA KEV catalog is discovered and --fail-on-kev is set
+	ErrKnownExploitedVulnerability = NewExpectedErr("discovered vulnerabilities in the CISA known exploited vulnerabilities catalog")
 )
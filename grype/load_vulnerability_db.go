@@ -1,8 +1,13 @@
 package grype
 
 import (
+	"fmt"
+
 	"github.com/anchore/grype/grype/db/legacy/distribution"
 	v5 "github.com/anchore/grype/grype/db/v5"
+	v6 "github.com/anchore/grype/grype/db/v6"
+	v6Distribution "github.com/anchore/grype/grype/db/v6/distribution"
+	"github.com/anchore/grype/grype/db/v6/installation"
 	"github.com/anchore/grype/internal/log"
 )
 
@@ -41,3 +46,43 @@ func LoadVulnerabilityDB(cfg distribution.Config, update bool) (*v5.ProviderStor
 
 	return s, &status, nil
 }
+
+// LoadVulnerabilityDBv6 installs (or updates) and opens a v6 vulnerability database, returning a provider store that
+// is backed entirely by the v6 schema and can be used by all matchers.
+func LoadVulnerabilityDBv6(distCfg v6Distribution.Config, installCfg installation.Config, update bool) (*v5.ProviderStore, *v6.Status, error) {
+	client, err := v6Distribution.NewClient(distCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create distribution client: %w", err)
+	}
+
+	dbCurator, err := installation.NewCurator(installCfg, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create curator: %w", err)
+	}
+
+	if update {
+		log.Debug("looking for vulnerability database updates")
+		_, err := dbCurator.Update()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	reader, err := dbCurator.Reader()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := dbCurator.Status()
+
+	p := v6.NewVulnerabilityProvider(reader)
+
+	s := &v5.ProviderStore{
+		VulnerabilityProvider:         p,
+		VulnerabilityMetadataProvider: p,
+		ExclusionProvider:             p,
+		Closer:                        p,
+	}
+
+	return s, &status, nil
+}
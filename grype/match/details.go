@@ -15,6 +15,9 @@ type Detail struct {
 	Found      interface{} // The specific attributes on the vulnerability object that were matched with --this indicates "what" was matched on / within.
 	Matcher    MatcherType // The matcher object that discovered the match.
 	Confidence float64     // The certainty of the match as a ratio (currently unused, reserved for future use).
+	// Reachability indicates if the vulnerable code is present in the scanned artifact (empty when not determined). This
+	// annotates the match and is not part of the identity of the detail.
+	Reachability Reachability `hash:"ignore"`
 }
 
 // String is the string representation of select match fields.
@@ -1,10 +1,15 @@
 package match
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/bmatcuk/doublestar/v2"
 
+	"github.com/anchore/grype/grype/version"
+	"github.com/anchore/grype/grype/vulnerability"
 	"github.com/anchore/grype/internal/log"
 )
 
@@ -21,14 +26,20 @@ type IgnoredMatch struct {
 // specified criteria must be met by the vulnerability match in order for the
 // rule to apply.
 type IgnoreRule struct {
-	Vulnerability    string            `yaml:"vulnerability" json:"vulnerability" mapstructure:"vulnerability"`
-	Reason           string            `yaml:"reason" json:"reason" mapstructure:"reason"`
-	Namespace        string            `yaml:"namespace" json:"namespace" mapstructure:"namespace"`
-	FixState         string            `yaml:"fix-state" json:"fix-state" mapstructure:"fix-state"`
-	Package          IgnoreRulePackage `yaml:"package" json:"package" mapstructure:"package"`
-	VexStatus        string            `yaml:"vex-status" json:"vex-status" mapstructure:"vex-status"`
-	VexJustification string            `yaml:"vex-justification" json:"vex-justification" mapstructure:"vex-justification"`
-	MatchType        Type              `yaml:"match-type" json:"match-type" mapstructure:"match-type"`
+	Vulnerability       string            `yaml:"vulnerability" json:"vulnerability" mapstructure:"vulnerability"`
+	Reason              string            `yaml:"reason" json:"reason" mapstructure:"reason"`
+	Namespace           string            `yaml:"namespace" json:"namespace" mapstructure:"namespace"`
+	FixState            string            `yaml:"fix-state" json:"fix-state" mapstructure:"fix-state"`
+	Package             IgnoreRulePackage `yaml:"package" json:"package" mapstructure:"package"`
+	VexStatus           string            `yaml:"vex-status" json:"vex-status" mapstructure:"vex-status"`
+	VexJustification    string            `yaml:"vex-justification" json:"vex-justification" mapstructure:"vex-justification"`
+	MatchType           Type              `yaml:"match-type" json:"match-type" mapstructure:"match-type"`
+	Severity            IgnoreRuleRange   `yaml:"severity" json:"severity" mapstructure:"severity"`
+	MaxCVSS             float64           `yaml:"max-cvss" json:"max-cvss" mapstructure:"max-cvss"`
+	Reachability        Reachability      `yaml:"reachability" json:"reachability" mapstructure:"reachability"`
+	VulnerabilityStatus string            `yaml:"vulnerability-status" json:"vulnerability-status" mapstructure:"vulnerability-status"`
+	Expires             string            `yaml:"expires" json:"expires" mapstructure:"expires"`
+	Owner               string            `yaml:"owner" json:"owner" mapstructure:"owner"`
 }
 
 // IgnoreRulePackage describes the Package-specific fields that comprise the IgnoreRule.
@@ -39,6 +50,75 @@ type IgnoreRulePackage struct {
 	Type         string `yaml:"type" json:"type" mapstructure:"type"`
 	Location     string `yaml:"location" json:"location" mapstructure:"location"`
 	UpstreamName string `yaml:"upstream-name" json:"upstream-name" mapstructure:"upstream-name"`
+	PURL         string `yaml:"purl" json:"purl" mapstructure:"purl"`
+	CPE          string `yaml:"cpe" json:"cpe" mapstructure:"cpe"`
+	// VersionConstraint is a constraint (e.g. "< 2.0") evaluated against the package version in the version format of the package
+	VersionConstraint string `yaml:"version-constraint" json:"version-constraint" mapstructure:"version-constraint"`
+}
+
+// IgnoreRuleRange describes an inclusive range of severities (e.g. min: negligible, max: low), either bound is optional.
+type IgnoreRuleRange struct {
+	Min string `yaml:"min" json:"min" mapstructure:"min"`
+	Max string `yaml:"max" json:"max" mapstructure:"max"`
+}
+
+// IsEmpty indicates if neither bound of the range is specified
+func (r IgnoreRuleRange) IsEmpty() bool {
+	return r.Min == "" && r.Max == ""
+}
+
+// Validate returns an error if either bound of the range is not a known severity
+func (r IgnoreRuleRange) Validate() error {
+	for _, s := range []string{r.Min, r.Max} {
+		if s != "" && vulnerability.ParseSeverity(s) == vulnerability.UnknownSeverity {
+			return fmt.Errorf("invalid ignore rule severity %q", s)
+		}
+	}
+	return nil
+}
+
+// expiresDateLayout is the layout for rule expiration dates without a time, such rules apply until the end of the day (UTC)
+const expiresDateLayout = "2006-01-02"
+
+// ExpiresAt returns the time at which the ignore rule stops applying. A zero time is returned when the rule does not
+// expire. The expiration may be given either as a date (e.g. 2025-06-30, which is valid until the end of that day)
+// or as an RFC 3339 timestamp.
+func (ir IgnoreRule) ExpiresAt() (time.Time, error) {
+	if ir.Expires == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(expiresDateLayout, ir.Expires); err == nil {
+		return t.AddDate(0, 0, 1), nil
+	}
+	t, err := time.Parse(time.RFC3339, ir.Expires)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid ignore rule expiration %q (expected a date like %q or an RFC 3339 timestamp)", ir.Expires, expiresDateLayout)
+	}
+	return t, nil
+}
+
+// IsExpired indicates if the ignore rule has expired as of the given time. Rules with an invalid expiration are
+// considered to be expired, so that they do not suppress findings indefinitely.
+func (ir IgnoreRule) IsExpired(at time.Time) bool {
+	if ir.Expires == "" {
+		return false
+	}
+	expiresAt, err := ir.ExpiresAt()
+	if err != nil {
+		return true
+	}
+	return !at.Before(expiresAt)
+}
+
+// ExpiredIgnoreRules returns the rules that have expired as of the given time.
+func ExpiredIgnoreRules(rules []IgnoreRule, at time.Time) []IgnoreRule {
+	var expired []IgnoreRule
+	for _, rule := range rules {
+		if rule.IsExpired(at) {
+			expired = append(expired, rule)
+		}
+	}
+	return expired
 }
 
 // ApplyIgnoreRules iterates through the provided matches and, for each match,
@@ -46,18 +126,40 @@ type IgnoreRulePackage struct {
 // provided IgnoreRules apply to the match. If any rules apply to the match, all
 // applicable rules are attached to the Match to form an IgnoredMatch.
 // ApplyIgnoreRules returns two collections: the matches that are not being
-// ignored, and the matches that are being ignored.
+// ignored, and the matches that are being ignored. Expired rules do not cause
+// matches to be ignored, instead they are recorded on the remaining match.
+// Rules with severity or CVSS criteria never apply, since there is no
+// vulnerability metadata available (see ApplyIgnoreRulesWithMetadata), and
+// rule expiration is evaluated at the current time.
 func ApplyIgnoreRules(matches Matches, rules []IgnoreRule) (Matches, []IgnoredMatch) {
+	return ApplyIgnoreRulesWithMetadata(matches, rules, nil, time.Now())
+}
+
+// ApplyIgnoreRulesWithMetadata is the same as ApplyIgnoreRules, but additionally
+// uses the given provider to evaluate the severity and CVSS criteria of rules,
+// and evaluates rule expiration at the given time.
+func ApplyIgnoreRulesWithMetadata(matches Matches, rules []IgnoreRule, metadataProvider vulnerability.MetadataProvider, at time.Time) (Matches, []IgnoredMatch) {
 	var ignoredMatches []IgnoredMatch
 	remainingMatches := NewMatches()
 
+	// rules are parsed once, rather than for every match
+	ruleConditions := make([][]ignoreCondition, len(rules))
+	for i, rule := range rules {
+		ruleConditions[i] = getIgnoreConditionsForRule(rule, metadataProvider)
+	}
+
 	for _, match := range matches.Sorted() {
 		var applicableRules []IgnoreRule
 
-		for _, rule := range rules {
-			if shouldIgnore(match, rule) {
-				applicableRules = append(applicableRules, rule)
+		for i, rule := range rules {
+			if !ignoreConditionsApply(match, rule, ruleConditions[i]) {
+				continue
+			}
+			if rule.IsExpired(at) {
+				match.ExpiredIgnoreRules = appendIgnoreRule(match.ExpiredIgnoreRules, rule)
+				continue
 			}
+			applicableRules = append(applicableRules, rule)
 		}
 
 		if len(applicableRules) > 0 {
@@ -75,13 +177,25 @@ func ApplyIgnoreRules(matches Matches, rules []IgnoreRule) (Matches, []IgnoredMa
 	return remainingMatches, ignoredMatches
 }
 
-func shouldIgnore(match Match, rule IgnoreRule) bool {
+func appendIgnoreRule(rules []IgnoreRule, rule IgnoreRule) []IgnoreRule {
+	for _, r := range rules {
+		if r == rule {
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
+
+func shouldIgnore(match Match, rule IgnoreRule, metadataProvider vulnerability.MetadataProvider) bool {
+	return ignoreConditionsApply(match, rule, getIgnoreConditionsForRule(rule, metadataProvider))
+}
+
+func ignoreConditionsApply(match Match, rule IgnoreRule, ignoreConditions []ignoreCondition) bool {
 	// VEX rules are handled by the vex processor
 	if rule.VexStatus != "" {
 		return false
 	}
 
-	ignoreConditions := getIgnoreConditionsForRule(rule)
 	if len(ignoreConditions) == 0 {
 		// this rule specifies no criteria, so it doesn't apply to the Match
 		return false
@@ -101,14 +215,14 @@ func shouldIgnore(match Match, rule IgnoreRule) bool {
 // HasConditions returns true if the ignore rule has conditions
 // that can cause a match to be ignored
 func (ir IgnoreRule) HasConditions() bool {
-	return len(getIgnoreConditionsForRule(ir)) == 0
+	return len(getIgnoreConditionsForRule(ir, nil)) > 0
 }
 
 // An ignoreCondition is a function that returns a boolean indicating whether
 // the given Match should be ignored.
 type ignoreCondition func(match Match) bool
 
-func getIgnoreConditionsForRule(rule IgnoreRule) []ignoreCondition {
+func getIgnoreConditionsForRule(rule IgnoreRule, metadataProvider vulnerability.MetadataProvider) []ignoreCondition {
 	var ignoreConditions []ignoreCondition
 
 	if v := rule.Vulnerability; v != "" {
@@ -150,6 +264,34 @@ func getIgnoreConditionsForRule(rule IgnoreRule) []ignoreCondition {
 	if matchType := rule.MatchType; matchType != "" {
 		ignoreConditions = append(ignoreConditions, ifMatchTypeApplies(matchType))
 	}
+
+	if r := rule.Reachability; r != "" {
+		ignoreConditions = append(ignoreConditions, ifReachabilityApplies(r))
+	}
+
+	if s := rule.VulnerabilityStatus; s != "" {
+		ignoreConditions = append(ignoreConditions, ifVulnerabilityStatusApplies(vulnerability.Status(s)))
+	}
+
+	if p := rule.Package.PURL; p != "" {
+		ignoreConditions = append(ignoreConditions, ifPackagePURLApplies(p))
+	}
+
+	if c := rule.Package.CPE; c != "" {
+		ignoreConditions = append(ignoreConditions, ifPackageCPEApplies(c))
+	}
+
+	if c := rule.Package.VersionConstraint; c != "" {
+		ignoreConditions = append(ignoreConditions, ifPackageVersionConstraintApplies(c))
+	}
+
+	if r := rule.Severity; !r.IsEmpty() {
+		ignoreConditions = append(ignoreConditions, ifSeverityApplies(r, metadataProvider))
+	}
+
+	if score := rule.MaxCVSS; score > 0 {
+		ignoreConditions = append(ignoreConditions, ifCVSSApplies(score, metadataProvider))
+	}
 	return ignoreConditions
 }
 
@@ -241,6 +383,159 @@ func ifMatchTypeApplies(matchType Type) ignoreCondition {
 	}
 }
 
+func ifReachabilityApplies(reachability Reachability) ignoreCondition {
+	return func(match Match) bool {
+		for _, d := range match.Details {
+			if d.Reachability == reachability {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func ifVulnerabilityStatusApplies(status vulnerability.Status) ignoreCondition {
+	return func(match Match) bool {
+		if status.IsActive() {
+			return match.Vulnerability.Status.IsActive()
+		}
+		return status == match.Vulnerability.Status
+	}
+}
+
+// globRegex converts a glob pattern, where "*" matches any sequence of characters (including "/") and "?" matches a
+// single character, to a regular expression
+func globRegex(glob string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.Compile(sb.String())
+}
+
+func ifPackagePURLApplies(purl string) ignoreCondition {
+	pattern, err := globRegex(purl)
+	if err != nil {
+		log.WithFields("purl", purl, "error", err).Debug("unable to parse purl pattern")
+		return func(Match) bool { return false }
+	}
+	return func(match Match) bool {
+		return match.Package.PURL != "" && pattern.MatchString(match.Package.PURL)
+	}
+}
+
+func ifPackageCPEApplies(c string) ignoreCondition {
+	pattern, err := globRegex(c)
+	if err != nil {
+		log.WithFields("cpe", c, "error", err).Debug("unable to parse cpe pattern")
+		return func(Match) bool { return false }
+	}
+	return func(match Match) bool {
+		for _, pc := range match.Package.CPEs {
+			if pattern.MatchString(pc.Attributes.BindToFmtString()) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func ifPackageVersionConstraintApplies(constraint string) ignoreCondition {
+	// the constraint is parsed once for each version format it is evaluated in
+	constraints := make(map[version.Format]version.Constraint)
+	return func(match Match) bool {
+		format := version.FormatFromPkg(match.Package)
+
+		c, ok := constraints[format]
+		if !ok {
+			var err error
+			c, err = version.GetConstraint(constraint, format)
+			if err != nil {
+				log.WithFields("constraint", constraint, "format", format, "error", err).Debug("unable to parse version constraint")
+				c = nil
+			}
+			constraints[format] = c
+		}
+		if c == nil {
+			return false
+		}
+
+		v, err := version.NewVersion(match.Package.Version, format)
+		if err != nil {
+			log.WithFields("version", match.Package.Version, "format", format, "error", err).Debug("unable to parse package version")
+			return false
+		}
+
+		satisfied, err := c.Satisfied(v)
+		if err != nil {
+			log.WithFields("constraint", constraint, "version", match.Package.Version, "error", err).Debug("unable to check version constraint")
+			return false
+		}
+		return satisfied
+	}
+}
+
+// vulnerabilityMetadata returns the metadata for the vulnerability of the match, or nil if it is not available
+func vulnerabilityMetadata(match Match, metadataProvider vulnerability.MetadataProvider) *vulnerability.Metadata {
+	if metadataProvider == nil {
+		return nil
+	}
+	metadata, err := metadataProvider.VulnerabilityMetadata(match.Vulnerability.Reference)
+	if err != nil {
+		log.WithFields("vuln", match.Vulnerability.ID, "error", err).Debug("unable to fetch vulnerability metadata for ignore rule")
+		return nil
+	}
+	return metadata
+}
+
+func ifSeverityApplies(r IgnoreRuleRange, metadataProvider vulnerability.MetadataProvider) ignoreCondition {
+	return func(match Match) bool {
+		metadata := vulnerabilityMetadata(match, metadataProvider)
+		if metadata == nil {
+			return false
+		}
+
+		severity := vulnerability.ParseSeverity(metadata.Severity)
+		if severity == vulnerability.UnknownSeverity {
+			return false
+		}
+		if r.Min != "" && severity < vulnerability.ParseSeverity(r.Min) {
+			return false
+		}
+		if r.Max != "" && severity > vulnerability.ParseSeverity(r.Max) {
+			return false
+		}
+		return true
+	}
+}
+
+// ifCVSSApplies checks that the highest CVSS base score of the vulnerability is at or below the given score. Note that
+// vulnerabilities without any CVSS scores are never considered to be below the score.
+func ifCVSSApplies(maxScore float64, metadataProvider vulnerability.MetadataProvider) ignoreCondition {
+	return func(match Match) bool {
+		metadata := vulnerabilityMetadata(match, metadataProvider)
+		if metadata == nil || len(metadata.Cvss) == 0 {
+			return false
+		}
+
+		for _, c := range metadata.Cvss {
+			if c.Metrics.BaseScore > maxScore {
+				return false
+			}
+		}
+		return true
+	}
+}
+
 func ruleLocationAppliesToMatch(location string, match Match) bool {
 	for _, packageLocation := range match.Package.Locations.ToSlice() {
 		if ruleLocationAppliesToPath(location, packageLocation.RealPath) {
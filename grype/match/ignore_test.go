@@ -2,12 +2,14 @@ package match
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anchore/grype/grype/pkg"
 	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/cpe"
 	"github.com/anchore/syft/syft/file"
 	syftPkg "github.com/anchore/syft/syft/pkg"
 )
@@ -770,6 +772,251 @@ func TestApplyIgnoreRules(t *testing.T) {
 	}
 }
 
+func TestApplyIgnoreRules_ExpiredRules(t *testing.T) {
+	at := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	activeRule := IgnoreRule{Vulnerability: "CVE-123", Expires: "2025-06-30", Owner: "team-a"}
+	expiredRule := IgnoreRule{Vulnerability: "CVE-456", Expires: "2025-06-29", Owner: "team-b"}
+	expiredTimestampRule := IgnoreRule{Vulnerability: "CVE-456", Expires: "2025-06-30T11:59:59Z"}
+
+	remaining, ignored := ApplyIgnoreRulesWithMetadata(sliceToMatches(allMatches), []IgnoreRule{activeRule, expiredRule, expiredTimestampRule}, nil, at)
+
+	assert.Equal(t, []IgnoreRule{expiredRule, expiredTimestampRule}, ExpiredIgnoreRules([]IgnoreRule{activeRule, expiredRule, expiredTimestampRule}, at))
+
+	expired := allMatches[1]
+	expired.ExpiredIgnoreRules = []IgnoreRule{expiredRule, expiredTimestampRule}
+
+	assertMatchOrder(t, []Match{expired, allMatches[2], allMatches[3]}, remaining.Sorted())
+	assertIgnoredMatchOrder(t, []IgnoredMatch{
+		{
+			Match:              allMatches[0],
+			AppliedIgnoreRules: []IgnoreRule{activeRule},
+		},
+	}, ignored)
+}
+
+func TestApplyIgnoreRules_VersionConstraintAcrossFormats(t *testing.T) {
+	newMatch := func(pkgType syftPkg.Type, v string) Match {
+		return Match{
+			Vulnerability: vulnerability.Vulnerability{Reference: vulnerability.Reference{ID: "CVE-2000-1234"}},
+			Package:       pkg.Package{ID: pkg.ID(uuid.NewString()), Name: "a-pkg", Version: v, Type: pkgType},
+		}
+	}
+	// the constraint is satisfied by the deb version (because of the epoch), but not by the npm version
+	deb := newMatch(syftPkg.DebPkg, "1:0.9")
+	npm := newMatch(syftPkg.NpmPkg, "0.9.0")
+
+	rule := IgnoreRule{Package: IgnoreRulePackage{VersionConstraint: ">= 1.0"}}
+	remaining, ignored := ApplyIgnoreRules(NewMatches(deb, npm), []IgnoreRule{rule})
+
+	assertMatchOrder(t, []Match{npm}, remaining.Sorted())
+	assertIgnoredMatchOrder(t, []IgnoredMatch{{Match: deb, AppliedIgnoreRules: []IgnoreRule{rule}}}, ignored)
+}
+
+func TestIgnoreRule_HasConditions(t *testing.T) {
+	assert.False(t, IgnoreRule{}.HasConditions())
+	assert.False(t, IgnoreRule{Reason: "accepted", Expires: "2025-06-30", Owner: "team-a"}.HasConditions())
+	assert.True(t, IgnoreRule{Vulnerability: "CVE-2000-1234"}.HasConditions())
+	assert.True(t, IgnoreRule{Severity: IgnoreRuleRange{Max: "low"}}.HasConditions())
+}
+
+func TestIgnoreRule_IsExpired(t *testing.T) {
+	at := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expires string
+		want    bool
+		wantErr bool
+	}{
+		{expires: "", want: false},
+		{expires: "2025-07-01", want: false},
+		{expires: "2025-06-30", want: false},
+		{expires: "2025-06-29", want: true},
+		{expires: "2025-06-30T12:00:01Z", want: false},
+		{expires: "2025-06-30T12:00:00Z", want: true},
+		{expires: "2025-06-30T13:00:00+02:00", want: true},
+		{expires: "next tuesday", want: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expires, func(t *testing.T) {
+			rule := IgnoreRule{Expires: tt.expires}
+			_, err := rule.ExpiresAt()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, rule.IsExpired(at))
+		})
+	}
+}
+
+type stubMetadataProvider map[string]vulnerability.Metadata
+
+func (p stubMetadataProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	m, ok := p[ref.ID]
+	if !ok {
+		return nil, nil
+	}
+	return &m, nil
+}
+
+func TestShouldIgnore_PackageIdentifiersAndVulnerabilityMetadata(t *testing.T) {
+	m := Match{
+		Vulnerability: vulnerability.Vulnerability{
+			Reference: vulnerability.Reference{ID: "CVE-2000-1234"},
+		},
+		Package: pkg.Package{
+			ID:      pkg.ID(uuid.NewString()),
+			Name:    "test-helper",
+			Version: "1.4.2",
+			Type:    syftPkg.NpmPkg,
+			PURL:    "pkg:npm/%40acme/test-helper@1.4.2",
+			CPEs:    []cpe.CPE{cpe.Must("cpe:2.3:a:acme:test-helper:1.4.2:*:*:*:*:node.js:*:*", cpe.DeclaredSource)},
+		},
+	}
+
+	metadata := stubMetadataProvider{
+		"CVE-2000-1234": {
+			Severity: "Low",
+			Cvss:     []vulnerability.Cvss{{Metrics: vulnerability.CvssMetrics{BaseScore: 3.1}}, {Metrics: vulnerability.CvssMetrics{BaseScore: 3.7}}},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		rule     IgnoreRule
+		provider vulnerability.MetadataProvider
+		expected bool
+	}{
+		{
+			name:     "purl glob",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{PURL: "pkg:npm/*test-*"}},
+			expected: true,
+		},
+		{
+			name:     "purl glob does not match",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{PURL: "pkg:pypi/*"}},
+			expected: false,
+		},
+		{
+			name:     "cpe pattern",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{CPE: "cpe:2.3:a:acme:*"}},
+			expected: true,
+		},
+		{
+			name:     "cpe pattern does not match",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{CPE: "cpe:2.3:a:other:*"}},
+			expected: false,
+		},
+		{
+			name:     "version constraint",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{VersionConstraint: "< 2.0"}},
+			expected: true,
+		},
+		{
+			name:     "version constraint not satisfied",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{VersionConstraint: ">= 1.5, < 2.0"}},
+			expected: false,
+		},
+		{
+			name:     "invalid version constraint",
+			rule:     IgnoreRule{Package: IgnoreRulePackage{VersionConstraint: "~~ nope"}},
+			expected: false,
+		},
+		{
+			name:     "severity range",
+			rule:     IgnoreRule{Severity: IgnoreRuleRange{Min: "negligible", Max: "low"}},
+			provider: metadata,
+			expected: true,
+		},
+		{
+			name:     "severity above range",
+			rule:     IgnoreRule{Severity: IgnoreRuleRange{Max: "negligible"}},
+			provider: metadata,
+			expected: false,
+		},
+		{
+			name:     "severity below range",
+			rule:     IgnoreRule{Severity: IgnoreRuleRange{Min: "medium"}},
+			provider: metadata,
+			expected: false,
+		},
+		{
+			name:     "severity without metadata",
+			rule:     IgnoreRule{Severity: IgnoreRuleRange{Max: "critical"}},
+			expected: false,
+		},
+		{
+			name:     "max cvss",
+			rule:     IgnoreRule{MaxCVSS: 3.7},
+			provider: metadata,
+			expected: true,
+		},
+		{
+			name:     "cvss above max",
+			rule:     IgnoreRule{MaxCVSS: 3.5},
+			provider: metadata,
+			expected: false,
+		},
+		{
+			name:     "low severity test-only npm packages below 2.0",
+			rule:     IgnoreRule{Severity: IgnoreRuleRange{Max: "low"}, Package: IgnoreRulePackage{Type: "npm", Name: "test-.*", VersionConstraint: "< 2.0"}},
+			provider: metadata,
+			expected: true,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, shouldIgnore(m, testCase.rule, testCase.provider))
+		})
+	}
+}
+
+func TestIgnoreRuleRange_Validate(t *testing.T) {
+	assert.NoError(t, IgnoreRuleRange{}.Validate())
+	assert.NoError(t, IgnoreRuleRange{Min: "Low", Max: "critical"}.Validate())
+	assert.Error(t, IgnoreRuleRange{Max: "severe"}.Validate())
+}
+
+func TestShouldIgnore_Reachability(t *testing.T) {
+	m := Match{
+		Vulnerability: vulnerability.Vulnerability{
+			Reference: vulnerability.Reference{ID: "GO-2023-1988"},
+		},
+		Package: pkg.Package{
+			ID:      pkg.ID(uuid.NewString()),
+			Name:    "golang.org/x/net",
+			Version: "v0.12.0",
+			Type:    syftPkg.GoModulePkg,
+		},
+		Details: Details{
+			{Type: ExactDirectMatch, Matcher: GoModuleMatcher, Reachability: Unreachable},
+		},
+	}
+
+	assert.True(t, shouldIgnore(m, IgnoreRule{Reachability: Unreachable}, nil))
+	assert.False(t, shouldIgnore(m, IgnoreRule{Reachability: Reachable}, nil))
+
+	m.Details[0].Reachability = ReachabilityUnknown
+	assert.False(t, shouldIgnore(m, IgnoreRule{Reachability: Unreachable}, nil))
+}
+
+func TestShouldIgnore_VulnerabilityStatus(t *testing.T) {
+	m := exampleMatch
+
+	// records without a status are active
+	assert.True(t, shouldIgnore(m, IgnoreRule{VulnerabilityStatus: "active"}, nil))
+	assert.False(t, shouldIgnore(m, IgnoreRule{VulnerabilityStatus: "rejected"}, nil))
+
+	m.Vulnerability.Status = vulnerability.StatusRejected
+	assert.True(t, shouldIgnore(m, IgnoreRule{VulnerabilityStatus: "rejected"}, nil))
+	assert.False(t, shouldIgnore(m, IgnoreRule{VulnerabilityStatus: "active"}, nil))
+	assert.False(t, shouldIgnore(m, IgnoreRule{VulnerabilityStatus: "withdrawn"}, nil))
+}
+
 func sliceToMatches(s []Match) Matches {
 	matches := NewMatches()
 	matches.Add(s...)
@@ -902,7 +1149,7 @@ func TestShouldIgnore(t *testing.T) {
 
 	for _, testCase := range cases {
 		t.Run(testCase.name, func(t *testing.T) {
-			actual := shouldIgnore(testCase.match, testCase.rule)
+			actual := shouldIgnore(testCase.match, testCase.rule, nil)
 			assert.Equal(t, testCase.expected, actual)
 		})
 	}
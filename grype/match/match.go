@@ -19,6 +19,13 @@ type Match struct {
 	Vulnerability vulnerability.Vulnerability // The vulnerability details of the match.
 	Package       pkg.Package                 // The package used to search for a match.
 	Details       Details                     // all the ways this particular match was made.
+
+	// ExpiredIgnoreRules are the ignore rules that would apply to this match, but have expired.
+	ExpiredIgnoreRules []IgnoreRule
+
+	// FlaggedStatus is the status of the vulnerability record when it should be called out to the user (e.g. a disputed
+	// record), otherwise it is empty.
+	FlaggedStatus vulnerability.Status
 }
 
 // String is the string representation of select match fields.
@@ -77,6 +84,14 @@ func (m *Match) Merge(other Match) error {
 	// for stable output
 	sort.Sort(m.Details)
 
+	for _, r := range other.ExpiredIgnoreRules {
+		m.ExpiredIgnoreRules = appendIgnoreRule(m.ExpiredIgnoreRules, r)
+	}
+
+	if m.FlaggedStatus == "" {
+		m.FlaggedStatus = other.FlaggedStatus
+	}
+
 	// retain all unique CPEs for consistent output
 	m.Vulnerability.CPEs = cpe.Merge(m.Vulnerability.CPEs, other.Vulnerability.CPEs)
 	if m.Vulnerability.CPEs == nil {
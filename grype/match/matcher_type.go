@@ -1,21 +1,32 @@
 package match
 
 const (
-	UnknownMatcherType MatcherType = "UnknownMatcherType"
-	StockMatcher       MatcherType = "stock-matcher"
-	ApkMatcher         MatcherType = "apk-matcher"
-	RubyGemMatcher     MatcherType = "ruby-gem-matcher"
-	DpkgMatcher        MatcherType = "dpkg-matcher"
-	RpmMatcher         MatcherType = "rpm-matcher"
-	JavaMatcher        MatcherType = "java-matcher"
-	PythonMatcher      MatcherType = "python-matcher"
-	DotnetMatcher      MatcherType = "dotnet-matcher"
-	JavascriptMatcher  MatcherType = "javascript-matcher"
-	MsrcMatcher        MatcherType = "msrc-matcher"
-	PortageMatcher     MatcherType = "portage-matcher"
-	GoModuleMatcher    MatcherType = "go-module-matcher"
-	OpenVexMatcher     MatcherType = "openvex-matcher"
-	RustMatcher        MatcherType = "rust-matcher"
+	UnknownMatcherType   MatcherType = "UnknownMatcherType"
+	StockMatcher         MatcherType = "stock-matcher"
+	ApkMatcher           MatcherType = "apk-matcher"
+	RubyGemMatcher       MatcherType = "ruby-gem-matcher"
+	DpkgMatcher          MatcherType = "dpkg-matcher"
+	RpmMatcher           MatcherType = "rpm-matcher"
+	JavaMatcher          MatcherType = "java-matcher"
+	PythonMatcher        MatcherType = "python-matcher"
+	DotnetMatcher        MatcherType = "dotnet-matcher"
+	JavascriptMatcher    MatcherType = "javascript-matcher"
+	MsrcMatcher          MatcherType = "msrc-matcher"
+	PortageMatcher       MatcherType = "portage-matcher"
+	GoModuleMatcher      MatcherType = "go-module-matcher"
+	OpenVexMatcher       MatcherType = "openvex-matcher"
+	CycloneDXVexMatcher  MatcherType = "cyclonedx-vex-matcher"
+	CSAFVexMatcher       MatcherType = "csaf-vex-matcher"
+	RustMatcher          MatcherType = "rust-matcher"
+	PhpComposerMatcher   MatcherType = "php-composer-matcher"
+	SwiftMatcher         MatcherType = "swift-matcher"
+	DartPubMatcher       MatcherType = "dart-pub-matcher"
+	HexMatcher           MatcherType = "hex-matcher"
+	ConanMatcher         MatcherType = "conan-matcher"
+	AlpmMatcher          MatcherType = "alpm-matcher"
+	GithubActionsMatcher MatcherType = "github-actions-matcher"
+	KernelMatcher        MatcherType = "linux-kernel-matcher"
+	NixMatcher           MatcherType = "nix-matcher"
 )
 
 var AllMatcherTypes = []MatcherType{
@@ -31,7 +42,18 @@ var AllMatcherTypes = []MatcherType{
 	PortageMatcher,
 	GoModuleMatcher,
 	OpenVexMatcher,
+	CycloneDXVexMatcher,
+	CSAFVexMatcher,
 	RustMatcher,
+	PhpComposerMatcher,
+	SwiftMatcher,
+	DartPubMatcher,
+	HexMatcher,
+	ConanMatcher,
+	AlpmMatcher,
+	GithubActionsMatcher,
+	KernelMatcher,
+	NixMatcher,
 }
 
 type MatcherType string
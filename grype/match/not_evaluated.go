@@ -0,0 +1,25 @@
+package match
+
+import (
+	"fmt"
+
+	"github.com/anchore/grype/grype/pkg"
+)
+
+// NotEvaluatedError is returned by a matcher that is responsible for a package but was unable to search for
+// vulnerabilities for it (e.g. there is no known upstream project for the package). This is distinct from a package
+// that was searched and has no vulnerabilities.
+type NotEvaluatedError struct {
+	Reason string
+}
+
+func (e NotEvaluatedError) Error() string {
+	return fmt.Sprintf("package not evaluated: %s", e.Reason)
+}
+
+// A NotEvaluated package is one that a matcher was unable to search for vulnerabilities (see NotEvaluatedError).
+type NotEvaluated struct {
+	Package pkg.Package
+	Matcher MatcherType
+	Reason  string
+}
@@ -0,0 +1,15 @@
+package match
+
+const (
+	// Reachable indicates that the vulnerable code is present in the scanned artifact
+	Reachable Reachability = "reachable"
+	// Unreachable indicates that none of the vulnerable code is present in the scanned artifact
+	Unreachable Reachability = "unreachable"
+	// ReachabilityUnknown indicates that it could not be determined if the vulnerable code is present (e.g. the
+	// vulnerability does not list the affected symbols, or the symbols of the artifact could not be read)
+	ReachabilityUnknown Reachability = "unknown"
+)
+
+// Reachability indicates if the vulnerable code of a match is present in the scanned artifact. This is only determined
+// by matchers that are able to inspect the artifact (e.g. the symbol tables of Go binaries), otherwise it is empty.
+type Reachability string
@@ -8,6 +8,9 @@ const (
 	ExactDirectMatch   Type = "exact-direct-match"
 	ExactIndirectMatch Type = "exact-indirect-match"
 	CPEMatch           Type = "cpe-match"
+	// DistroNotAffected describes a distro record that suppressed a match, declaring that the distro package which
+	// owns the location of the matched package is not affected by the vulnerability
+	DistroNotAffected Type = "distro-not-affected"
 )
 
 var typeOrder = map[Type]int{
@@ -0,0 +1,14 @@
+package pkg
+
+// OwnedFiles returns the paths installed by the given package, which are only known for distro packages that list
+// them (e.g. apk, deb and rpm packages).
+func OwnedFiles(p Package) []string {
+	if m, ok := p.Metadata.(ApkMetadata); ok {
+		var files []string
+		for _, f := range m.Files {
+			files = append(files, f.Path)
+		}
+		return files
+	}
+	return p.Files
+}
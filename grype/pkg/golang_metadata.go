@@ -9,6 +9,8 @@ type GolangBinMetadata struct {
 	H1Digest          string        `json:"h1Digest,omitempty" cyclonedx:"h1Digest"`
 	MainModule        string        `json:"mainModule,omitempty" cyclonedx:"mainModule"`
 	GoCryptoSettings  []string      `json:"goCryptoSettings,omitempty" cyclonedx:"goCryptoSettings"`
+	// Symbols are the functions linked into the binary, nil when the binary could not be read (e.g. when scanning an SBOM)
+	Symbols *GolangSymbols `json:"-" cyclonedx:"-"`
 }
 
 type GolangModMetadata struct {
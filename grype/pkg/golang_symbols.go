@@ -0,0 +1,281 @@
+package pkg
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/gosym"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/scylladb/go-set/strset"
+
+	"github.com/anchore/grype/internal/log"
+	"github.com/anchore/syft/syft/file"
+)
+
+var (
+	// golangTypeParameters are the type parameters of instantiated generic functions (e.g. "Map[...]")
+	golangTypeParameters = regexp.MustCompile(`\[.*?\]`)
+	// golangClosureSuffix is the suffix of closures, which belong to the enclosing function (e.g. "Parse.func1.2")
+	golangClosureSuffix = regexp.MustCompile(`\.(func|deferwrap|gowrap)\d+(\.\d+)*$`)
+	// golangModuleVersion is the version in the module cache directory of a module (e.g. "golang.org/x/net@v0.17.0")
+	golangModuleVersion = regexp.MustCompile(`@[^/]+`)
+	// golangEscapedUpper is an upper case letter escaped in a module cache path (e.g. "github.com/!burnt!sushi")
+	golangEscapedUpper = regexp.MustCompile(`![a-z]`)
+)
+
+// GolangSymbols are the functions linked into a Go binary, by package path. The names of the functions are normalized
+// to the form used by the Go vulnerability database (e.g. "golang.org/x/net/html.(*Tokenizer).Next" is "Tokenizer.Next"
+// in the "golang.org/x/net/html" package).
+//
+// Functions that have been inlined into all of their callers are not in the function table of the binary, so a missing
+// symbol does not mean that the code of the symbol is not in the binary. The source files of inlined functions are
+// still in the line table though, so the packages with code in the binary are known.
+type GolangSymbols struct {
+	packages       map[string]*strset.Set
+	sourcePackages *strset.Set
+}
+
+// NewGolangSymbols creates the symbols of a Go binary from the names of its functions as they appear in the symbol
+// table (e.g. "golang.org/x/net/html.Parse").
+func NewGolangSymbols(names ...string) *GolangSymbols {
+	s := &GolangSymbols{packages: make(map[string]*strset.Set), sourcePackages: strset.New()}
+	for _, name := range names {
+		pkgPath, symbol := splitGolangSymbol(name)
+		if pkgPath == "" || symbol == "" {
+			continue
+		}
+		if _, ok := s.packages[pkgPath]; !ok {
+			s.packages[pkgPath] = strset.New()
+		}
+		s.packages[pkgPath].Add(symbol)
+	}
+	return s
+}
+
+// AddSourceFiles records the packages of the given source files of the binary (e.g.
+// "golang.org/x/net@v0.17.0/html/parse.go"), which includes the source files of inlined functions.
+func (s *GolangSymbols) AddSourceFiles(files ...string) *GolangSymbols {
+	for _, f := range files {
+		if pkgPath := golangSourcePackage(f); pkgPath != "" {
+			s.sourcePackages.Add(pkgPath)
+		}
+	}
+	return s
+}
+
+// HasPackage indicates if any code of the given package is in the binary (either a function of the package, or a
+// function of the package that was inlined)
+func (s *GolangSymbols) HasPackage(pkgPath string) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.packages[pkgPath]
+	return ok || s.sourcePackages.Has(pkgPath)
+}
+
+// Has indicates if the given symbol (e.g. "Parse" or "Tokenizer.Next") of the given package is linked into the binary
+func (s *GolangSymbols) Has(pkgPath, symbol string) bool {
+	if s == nil {
+		return false
+	}
+	symbols, ok := s.packages[pkgPath]
+	return ok && symbols.Has(symbol)
+}
+
+// splitGolangSymbol splits the name of a function into the package path and the normalized symbol, where the package
+// path ends at the first dot after the last slash (e.g. "golang.org/x/net/html.(*Tokenizer).Next" is split into
+// "golang.org/x/net/html" and "Tokenizer.Next"). Dots in the last element of the package path are escaped by the linker
+// (e.g. "gopkg.in/yaml%2ev3.Unmarshal").
+func splitGolangSymbol(name string) (string, string) {
+	name = golangTypeParameters.ReplaceAllString(name, "")
+	name = golangClosureSuffix.ReplaceAllString(name, "")
+
+	start := strings.LastIndex(name, "/") + 1
+	dot := strings.Index(name[start:], ".")
+	if dot < 0 {
+		return "", ""
+	}
+	pkgPath, symbol := strings.ReplaceAll(name[:start+dot], "%2e", "."), name[start+dot+1:]
+
+	// pointer receivers are written as "(*T).M"
+	symbol = strings.NewReplacer("(*", "", ")", "").Replace(symbol)
+	return pkgPath, symbol
+}
+
+// golangSourcePackage returns the package path of the given source file path from the line table of a binary. Files of
+// dependencies are either relative to the module cache (when built with -trimpath) or absolute paths within the module
+// cache or a vendor directory; files of the standard library are within the GOROOT.
+func golangSourcePackage(file string) string {
+	file = strings.ReplaceAll(file, "\\", "/")
+	if !strings.HasSuffix(file, ".go") {
+		return ""
+	}
+
+	for _, marker := range []string{"/pkg/mod/", "/vendor/", "/src/"} {
+		if i := strings.LastIndex(file, marker); i >= 0 {
+			file = file[i+len(marker):]
+			break
+		}
+	}
+	file = strings.TrimPrefix(file, "/")
+
+	file = golangModuleVersion.ReplaceAllString(file, "")
+	file = golangEscapedUpper.ReplaceAllStringFunc(file, func(s string) string {
+		return strings.ToUpper(s[1:])
+	})
+
+	dir := path.Dir(file)
+	if dir == "." {
+		return ""
+	}
+	return dir
+}
+
+// addGolangSymbols reads the symbols of the Go binaries the given packages were found in, so that matchers are able to
+// determine if the vulnerable functions of a module are linked into the binary
+func addGolangSymbols(resolver file.Resolver, packages []Package) {
+	symbolsByBinary := make(map[file.Coordinates]*GolangSymbols)
+	for i := range packages {
+		metadata, ok := packages[i].Metadata.(GolangBinMetadata)
+		if !ok {
+			continue
+		}
+
+		locations := packages[i].Locations.ToSlice()
+		if len(locations) == 0 {
+			continue
+		}
+		location := locations[0]
+
+		symbols, ok := symbolsByBinary[location.Coordinates]
+		if !ok {
+			var err error
+			symbols, err = readGolangSymbolsAt(resolver, location)
+			if err != nil {
+				log.WithFields("path", location.RealPath, "error", err).Debug("unable to read go binary symbols")
+			}
+			symbolsByBinary[location.Coordinates] = symbols
+		}
+
+		metadata.Symbols = symbols
+		packages[i].Metadata = metadata
+	}
+}
+
+func readGolangSymbolsAt(resolver file.Resolver, location file.Location) (*GolangSymbols, error) {
+	reader, err := resolver.FileContentsByLocation(location)
+	if err != nil {
+		return nil, err
+	}
+	defer log.CloseAndLogError(reader, location.RealPath)
+
+	if r, ok := reader.(io.ReaderAt); ok {
+		return readGolangSymbols(r)
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	return readGolangSymbols(bytes.NewReader(data))
+}
+
+// readGolangSymbols reads the names of the functions linked into the given Go binary (ELF, Mach-O or PE). The function
+// table (pclntab) is preferred since it is present in stripped binaries and has the source files of inlined functions,
+// otherwise the symbol table is used. Note that functions which have been inlined into all callers are not present in
+// either table.
+func readGolangSymbols(r io.ReaderAt) (*GolangSymbols, error) {
+	if f, err := elf.NewFile(r); err == nil {
+		defer f.Close()
+		return golangSymbolsFromELF(f)
+	}
+	if f, err := macho.NewFile(r); err == nil {
+		defer f.Close()
+		return golangSymbolsFromMachO(f)
+	}
+	if f, err := pe.NewFile(r); err == nil {
+		defer f.Close()
+		return golangSymbolsFromPE(f)
+	}
+	return nil, errors.New("unrecognized binary format")
+}
+
+func golangSymbolsFromELF(f *elf.File) (*GolangSymbols, error) {
+	text := f.Section(".text")
+	for _, name := range []string{".gopclntab", ".data.rel.ro.gopclntab"} {
+		if pclntab := f.Section(name); pclntab != nil && text != nil {
+			data, err := pclntab.Data()
+			if err != nil {
+				return nil, fmt.Errorf("unable to read %s: %w", name, err)
+			}
+			return golangSymbolsFromPclntab(data, text.Addr)
+		}
+	}
+
+	symbols, err := f.Symbols()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read symbol table: %w", err)
+	}
+	var names []string
+	for _, s := range symbols {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC {
+			names = append(names, s.Name)
+		}
+	}
+	return NewGolangSymbols(names...), nil
+}
+
+func golangSymbolsFromMachO(f *macho.File) (*GolangSymbols, error) {
+	text := f.Section("__text")
+	if pclntab := f.Section("__gopclntab"); pclntab != nil && text != nil {
+		data, err := pclntab.Data()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read __gopclntab: %w", err)
+		}
+		return golangSymbolsFromPclntab(data, text.Addr)
+	}
+
+	if f.Symtab == nil {
+		return nil, errors.New("no symbol table")
+	}
+	var names []string
+	for _, s := range f.Symtab.Syms {
+		names = append(names, strings.TrimPrefix(s.Name, "_"))
+	}
+	return NewGolangSymbols(names...), nil
+}
+
+func golangSymbolsFromPE(f *pe.File) (*GolangSymbols, error) {
+	// the function table of PE binaries is not in a dedicated section, so only the symbol table can be used
+	if len(f.Symbols) == 0 {
+		return nil, errors.New("no symbol table")
+	}
+	var names []string
+	for _, s := range f.Symbols {
+		names = append(names, s.Name)
+	}
+	return NewGolangSymbols(names...), nil
+}
+
+func golangSymbolsFromPclntab(data []byte, textStart uint64) (*GolangSymbols, error) {
+	table, err := gosym.NewTable(nil, gosym.NewLineTable(data, textStart))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read function table: %w", err)
+	}
+	names := make([]string, 0, len(table.Funcs))
+	for _, fn := range table.Funcs {
+		names = append(names, fn.Name)
+	}
+	files := make([]string, 0, len(table.Files))
+	for f := range table.Files {
+		files = append(files, f)
+	}
+	return NewGolangSymbols(names...).AddSourceFiles(files...), nil
+}
@@ -0,0 +1,133 @@
+package pkg
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/syft/syft/file"
+)
+
+func TestSplitGolangSymbol(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkgPath string
+		symbol  string
+	}{
+		{name: "golang.org/x/net/html.Parse", pkgPath: "golang.org/x/net/html", symbol: "Parse"},
+		{name: "golang.org/x/net/html.(*Tokenizer).Next", pkgPath: "golang.org/x/net/html", symbol: "Tokenizer.Next"},
+		{name: "golang.org/x/net/html.Tokenizer.Raw", pkgPath: "golang.org/x/net/html", symbol: "Tokenizer.Raw"},
+		{name: "net/http.(*Server).Serve", pkgPath: "net/http", symbol: "Server.Serve"},
+		{name: "fmt.Println", pkgPath: "fmt", symbol: "Println"},
+		{name: "gopkg.in/yaml%2ev3.Unmarshal", pkgPath: "gopkg.in/yaml.v3", symbol: "Unmarshal"},
+		{name: "golang.org/x/exp/slices.Sort[...]", pkgPath: "golang.org/x/exp/slices", symbol: "Sort"},
+		{name: "github.com/a/b.(*Tree[...]).Insert", pkgPath: "github.com/a/b", symbol: "Tree.Insert"},
+		{name: "golang.org/x/net/html.Parse.func1.2", pkgPath: "golang.org/x/net/html", symbol: "Parse"},
+		{name: "go:buildid"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pkgPath, symbol := splitGolangSymbol(test.name)
+			assert.Equal(t, test.pkgPath, pkgPath)
+			assert.Equal(t, test.symbol, symbol)
+		})
+	}
+}
+
+func TestGolangSymbols(t *testing.T) {
+	s := NewGolangSymbols("golang.org/x/net/html.Parse", "golang.org/x/net/html.(*Tokenizer).Next")
+
+	assert.True(t, s.HasPackage("golang.org/x/net/html"))
+	assert.False(t, s.HasPackage("golang.org/x/net/http2"))
+	assert.True(t, s.Has("golang.org/x/net/html", "Tokenizer.Next"))
+	assert.False(t, s.Has("golang.org/x/net/html", "ParseFragment"))
+
+	// packages with inlined functions only have source files in the binary
+	s.AddSourceFiles("golang.org/x/net@v0.17.0/http2/frame.go")
+	assert.True(t, s.HasPackage("golang.org/x/net/http2"))
+	assert.False(t, s.Has("golang.org/x/net/http2", "Framer.ReadFrame"))
+
+	var empty *GolangSymbols
+	assert.False(t, empty.HasPackage("golang.org/x/net/html"))
+	assert.False(t, empty.Has("golang.org/x/net/html", "Parse"))
+}
+
+func TestGolangSourcePackage(t *testing.T) {
+	tests := []struct {
+		file     string
+		expected string
+	}{
+		{file: "golang.org/x/net@v0.17.0/html/parse.go", expected: "golang.org/x/net/html"},
+		{file: "/root/go/pkg/mod/golang.org/x/net@v0.17.0/html/parse.go", expected: "golang.org/x/net/html"},
+		{file: "/root/go/pkg/mod/github.com/!burnt!sushi/toml@v1.3.2/decode.go", expected: "github.com/BurntSushi/toml"},
+		{file: "/src/app/vendor/golang.org/x/net/html/parse.go", expected: "golang.org/x/net/html"},
+		{file: "/usr/local/go/src/net/http/server.go", expected: "net/http"},
+		{file: `C:\Users\me\go\pkg\mod\golang.org\x\net@v0.17.0\html\parse.go`, expected: "golang.org/x/net/html"},
+		{file: "<autogenerated>"},
+		{file: "main.go"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			assert.Equal(t, test.expected, golangSourcePackage(test.file))
+		})
+	}
+}
+
+func TestReadGolangSymbols(t *testing.T) {
+	// the test binary is a go binary that includes this package
+	path, err := os.Executable()
+	require.NoError(t, err)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	s, err := readGolangSymbols(f)
+	require.NoError(t, err)
+	assert.True(t, s.Has("github.com/anchore/grype/grype/pkg", "readGolangSymbols"))
+	assert.True(t, s.Has("github.com/anchore/grype/grype/pkg", "GolangSymbols.Has"))
+	assert.False(t, s.Has("github.com/anchore/grype/grype/pkg", "notAFunction"))
+
+	_, err = readGolangSymbols(strings.NewReader("not a binary"))
+	assert.Error(t, err)
+}
+
+func TestAddGolangSymbols(t *testing.T) {
+	path, err := os.Executable()
+	require.NoError(t, err)
+
+	packages := []Package{
+		{
+			Name:      "github.com/anchore/grype",
+			Locations: file.NewLocationSet(file.NewLocation(path)),
+			Metadata:  GolangBinMetadata{MainModule: "github.com/anchore/grype"},
+		},
+		{
+			Name:      "golang.org/x/net",
+			Locations: file.NewLocationSet(file.NewLocation("/does/not/exist")),
+			Metadata:  GolangBinMetadata{},
+		},
+		{
+			Name:      "libc",
+			Locations: file.NewLocationSet(file.NewLocation(path)),
+		},
+	}
+
+	addGolangSymbols(file.NewMockResolverForPaths(path), packages)
+
+	metadata, ok := packages[0].Metadata.(GolangBinMetadata)
+	require.True(t, ok)
+	assert.Equal(t, "github.com/anchore/grype", metadata.MainModule)
+	assert.True(t, metadata.Symbols.Has("github.com/anchore/grype/grype/pkg", "addGolangSymbols"))
+
+	metadata, ok = packages[1].Metadata.(GolangBinMetadata)
+	require.True(t, ok)
+	assert.Nil(t, metadata.Symbols)
+
+	assert.Nil(t, packages[2].Metadata)
+}
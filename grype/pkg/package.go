@@ -40,6 +40,7 @@ type Package struct {
 	PURL      string    // the Package URL (see https://github.com/package-url/purl-spec)
 	Upstreams []UpstreamPackage
 	Metadata  interface{} // This is NOT 1-for-1 the syft metadata! Only the select data needed for vulnerability matching
+	Files     []string    // the paths installed by dpkg and rpm packages (note: these are not part of the output, see OwnedFiles)
 }
 
 func New(p pkg.Package) Package {
@@ -67,6 +68,7 @@ func New(p pkg.Package) Package {
 		PURL:      p.PURL,
 		Upstreams: upstreams,
 		Metadata:  metadata,
+		Files:     ownedFiles(p),
 	}
 }
 
@@ -215,6 +217,8 @@ func dataFromPkg(p pkg.Package) (interface{}, []UpstreamPackage) {
 	case pkg.ApkDBEntry:
 		metadata = apkMetadataFromPkg(p)
 		upstreams = apkDataFromPkg(p)
+	case pkg.AlpmDBEntry:
+		upstreams = alpmDataFromPkg(p)
 	case pkg.JavaVMInstallation:
 		metadata = javaVMDataFromPkg(p)
 	}
@@ -274,6 +278,22 @@ func golangMetadataFromPkg(p pkg.Package) interface{} {
 	return nil
 }
 
+// ownedFiles returns the paths installed by dpkg and rpm packages (apk packages list these in the metadata instead).
+func ownedFiles(p pkg.Package) []string {
+	var files []string
+	switch m := p.Metadata.(type) {
+	case pkg.DpkgDBEntry:
+		for _, record := range m.Files {
+			files = append(files, record.Path)
+		}
+	case pkg.RpmDBEntry:
+		files = rpmFilePaths(m.Files)
+	case pkg.RpmArchive:
+		files = rpmFilePaths(m.Files)
+	}
+	return files
+}
+
 func dpkgDataFromPkg(p pkg.Package) (upstreams []UpstreamPackage) {
 	if value, ok := p.Metadata.(pkg.DpkgDBEntry); ok {
 		if value.Source != "" {
@@ -312,6 +332,14 @@ func rpmDataFromPkg(p pkg.Package) (metadata *RpmMetadata, upstreams []UpstreamP
 	return metadata, upstreams
 }
 
+func rpmFilePaths(records []pkg.RpmFileRecord) []string {
+	var paths []string
+	for _, record := range records {
+		paths = append(paths, record.Path)
+	}
+	return paths
+}
+
 func handleSourceRPM(pkgName, sourceRpm string) []UpstreamPackage {
 	var upstreams []UpstreamPackage
 	name, version := getNameAndELVersion(sourceRpm)
@@ -388,6 +416,19 @@ func apkDataFromPkg(p pkg.Package) (upstreams []UpstreamPackage) {
 	return upstreams
 }
 
+func alpmDataFromPkg(p pkg.Package) (upstreams []UpstreamPackage) {
+	if value, ok := p.Metadata.(pkg.AlpmDBEntry); ok {
+		if value.BasePackage != "" && value.BasePackage != p.Name {
+			upstreams = append(upstreams, UpstreamPackage{
+				Name: value.BasePackage,
+			})
+		}
+	} else {
+		log.Warnf("unable to extract ALPM metadata for %s", p)
+	}
+	return upstreams
+}
+
 func ByID(id ID, pkgs []Package) *Package {
 	for _, p := range pkgs {
 		if p.ID == id {
@@ -23,6 +23,7 @@ func TestNew(t *testing.T) {
 		syftPkg   syftPkg.Package
 		metadata  interface{}
 		upstreams []UpstreamPackage
+		files     []string
 	}{
 		{
 			name: "alpm package with source info",
@@ -37,6 +38,11 @@ func TestNew(t *testing.T) {
 					}},
 				},
 			},
+			upstreams: []UpstreamPackage{
+				{
+					Name: "base-pkg-info",
+				},
+			},
 		},
 		{
 			name: "dpkg with source info",
@@ -61,6 +67,7 @@ func TestNew(t *testing.T) {
 					},
 				},
 			},
+			files: []string{"path-info"},
 			upstreams: []UpstreamPackage{
 				{
 					Name:    "src-info",
@@ -99,6 +106,7 @@ func TestNew(t *testing.T) {
 			metadata: RpmMetadata{
 				Epoch: intRef(30),
 			},
+			files: []string{"path-info"},
 			upstreams: []UpstreamPackage{
 				{
 					Name:    "sqlite",
@@ -137,6 +145,7 @@ func TestNew(t *testing.T) {
 			metadata: RpmMetadata{
 				Epoch: intRef(30),
 			},
+			files: []string{"path-info"},
 			upstreams: []UpstreamPackage{
 				{
 					Name:    "sqlite",
@@ -769,6 +778,7 @@ func TestNew(t *testing.T) {
 			p := New(test.syftPkg)
 			assert.Equal(t, test.metadata, p.Metadata, "unexpected metadata")
 			assert.Equal(t, test.upstreams, p.Upstreams, "unexpected upstream")
+			assert.Equal(t, test.files, p.Files, "unexpected files")
 		})
 	}
 }
@@ -1032,3 +1042,13 @@ func withDistro(s *sbom.SBOM, id string) *sbom.SBOM {
 func strRef(s string) *string {
 	return &s
 }
+
+func TestOwnedFiles(t *testing.T) {
+	apk := Package{Metadata: ApkMetadata{Files: []ApkFileRecord{{Path: "/usr/lib/python3.11/site-packages/six.py"}}}}
+	assert.Equal(t, []string{"/usr/lib/python3.11/site-packages/six.py"}, OwnedFiles(apk))
+
+	deb := Package{Files: []string{"/usr/lib/python3/dist-packages/six.py"}}
+	assert.Equal(t, []string{"/usr/lib/python3/dist-packages/six.py"}, OwnedFiles(deb))
+
+	assert.Empty(t, OwnedFiles(Package{Metadata: JavaMetadata{}}))
+}
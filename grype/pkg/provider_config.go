@@ -17,6 +17,9 @@ type SyftProviderConfig struct {
 	Exclusions             []string
 	Name                   string
 	DefaultImagePullSource string
+	// ReadGolangSymbols reads the functions linked into Go binaries, which is needed to determine the reachability of
+	// Go vulnerabilities
+	ReadGolangSymbols bool
 }
 
 type SynthesisConfig struct {
@@ -0,0 +1,42 @@
+package goimports
+
+import (
+	"github.com/anchore/grype/grype/distro"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/pkg/qualifier"
+)
+
+// Import is a Go package affected by a vulnerability, along with the affected symbols of the package (e.g. "Parse" or
+// "Tokenizer.Next"). When no symbols are given, all symbols of the package are affected.
+type Import struct {
+	Path    string
+	Symbols []string
+}
+
+type goImports struct {
+	imports []Import
+}
+
+func New(imports []Import) qualifier.Qualifier {
+	return &goImports{imports: imports}
+}
+
+// Satisfied is always true: the affected symbols do not determine if a module version is vulnerable, they are used to
+// determine if the vulnerable code is reachable (see FromQualifiers).
+func (g goImports) Satisfied(_ *distro.Distro, _ pkg.Package) (bool, error) {
+	return true, nil
+}
+
+// FromQualifiers returns the affected Go packages listed by the given qualifiers, and false when there is no such
+// qualifier (i.e. the affected symbols of the vulnerability are not known).
+func FromQualifiers(qualifiers []qualifier.Qualifier) ([]Import, bool) {
+	var imports []Import
+	var found bool
+	for _, q := range qualifiers {
+		if g, ok := q.(*goImports); ok {
+			imports = append(imports, g.imports...)
+			found = true
+		}
+	}
+	return imports, found
+}
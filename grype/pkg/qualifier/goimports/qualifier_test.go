@@ -0,0 +1,36 @@
+package goimports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/pkg/qualifier"
+	"github.com/anchore/grype/grype/pkg/qualifier/platformcpe"
+)
+
+func TestGoImports_Satisfied(t *testing.T) {
+	q := New([]Import{{Path: "golang.org/x/net/html", Symbols: []string{"Parse"}}})
+
+	satisfied, err := q.Satisfied(nil, pkg.Package{Name: "golang.org/x/net"})
+	assert.NoError(t, err)
+	assert.True(t, satisfied)
+}
+
+func TestFromQualifiers(t *testing.T) {
+	imports, found := FromQualifiers([]qualifier.Qualifier{
+		platformcpe.New("cpe:2.3:o:microsoft:windows:-:*:*:*:*:*:*:*"),
+		New([]Import{{Path: "golang.org/x/net/html", Symbols: []string{"Parse", "Tokenizer.Next"}}}),
+		New([]Import{{Path: "golang.org/x/net/http2"}}),
+	})
+	assert.True(t, found)
+	assert.Equal(t, []Import{
+		{Path: "golang.org/x/net/html", Symbols: []string{"Parse", "Tokenizer.Next"}},
+		{Path: "golang.org/x/net/http2"},
+	}, imports)
+
+	imports, found = FromQualifiers([]qualifier.Qualifier{platformcpe.New("cpe:2.3:o:microsoft:windows:-:*:*:*:*:*:*:*")})
+	assert.False(t, found)
+	assert.Empty(t, imports)
+}
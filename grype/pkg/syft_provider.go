@@ -42,6 +42,14 @@ func syftProvider(userInput string, config ProviderConfig) ([]Package, Context,
 	srcDescription := src.Describe()
 
 	packages := FromCollection(pkgCatalog, config.SynthesisConfig)
+
+	if config.ReadGolangSymbols {
+		if resolver, err := src.FileResolver(config.SBOMOptions.Search.Scope); err == nil {
+			addGolangSymbols(resolver, packages)
+		} else {
+			log.WithFields("error", err).Debug("unable to read go binary symbols")
+		}
+	}
 	pkgCtx := Context{
 		Source: &srcDescription,
 		Distro: s.Artifacts.LinuxDistribution,
@@ -14,6 +14,9 @@ import (
 	"github.com/anchore/packageurl-go"
 )
 
+// vulnerabilityStatusProperty is the name of the property holding the status of a flagged vulnerability record
+const vulnerabilityStatusProperty = "grype:vulnerability-status"
+
 // https://cyclonedx.org/docs/1.4/json/#vulnerabilities_items_bom-ref
 
 // NewVulnerability creates a Vulnerability document from a match and the metadata provider
@@ -77,10 +80,23 @@ func NewVulnerability(m match.Match, p vulnerability.MetadataProvider) (v cyclon
 		Tools: nil,
 		// TODO:  we do not leverage the following fields in our model
 		Analysis:   nil,
-		Properties: nil,
+		Properties: properties(m),
 	}, nil
 }
 
+// properties describes any aspects of the match that have been called out (e.g. a disputed vulnerability record)
+func properties(m match.Match) *[]cyclonedx.Property {
+	if m.FlaggedStatus == "" {
+		return nil
+	}
+	return &[]cyclonedx.Property{
+		{
+			Name:  vulnerabilityStatusProperty,
+			Value: string(m.FlaggedStatus),
+		},
+	}
+}
+
 func generateCDXRatings(metadata *vulnerability.Metadata) []cyclonedx.VulnerabilityRating {
 	severity := cdxSeverityFromGrypeSeverity(metadata.Severity)
 
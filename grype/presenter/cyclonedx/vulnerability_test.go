@@ -145,3 +145,16 @@ func TestNewVulnerability_AlwaysIncludesSeverity(t *testing.T) {
 		})
 	}
 }
+
+func TestNewVulnerability_FlaggedStatus(t *testing.T) {
+	provider := &metadataProvider{severity: "High"}
+
+	actual, err := NewVulnerability(match.Match{}, provider)
+	require.NoError(t, err)
+	assert.Nil(t, actual.Properties)
+
+	actual, err = NewVulnerability(match.Match{FlaggedStatus: vulnerability.StatusDisputed}, provider)
+	require.NoError(t, err)
+	require.NotNil(t, actual.Properties)
+	assert.Equal(t, []cyclonedx.Property{{Name: "grype:vulnerability-status", Value: "disputed"}}, *actual.Properties)
+}
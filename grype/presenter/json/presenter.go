@@ -16,6 +16,8 @@ type Presenter struct {
 	id               clio.Identification
 	matches          match.Matches
 	ignoredMatches   []match.IgnoredMatch
+	resolvedMatches  []models.Match
+	notEvaluated     []match.NotEvaluated
 	packages         []pkg.Package
 	context          pkg.Context
 	metadataProvider vulnerability.MetadataProvider
@@ -29,6 +31,8 @@ func NewPresenter(pb models.PresenterConfig) *Presenter {
 		id:               pb.ID,
 		matches:          pb.Matches,
 		ignoredMatches:   pb.IgnoredMatches,
+		resolvedMatches:  pb.ResolvedMatches,
+		notEvaluated:     pb.NotEvaluated,
 		packages:         pb.Packages,
 		metadataProvider: pb.MetadataProvider,
 		context:          pb.Context,
@@ -44,6 +48,8 @@ func (pres *Presenter) Present(output io.Writer) error {
 	if err != nil {
 		return err
 	}
+	doc.ResolvedMatches = pres.resolvedMatches
+	doc.NotEvaluated = models.NewNotEvaluatedPackages(pres.notEvaluated)
 
 	enc := json.NewEncoder(output)
 	// prevent > and < from being escaped in the payload
@@ -2,12 +2,14 @@ package json
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
 	"regexp"
 	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/clio"
 	"github.com/anchore/go-testutils"
@@ -16,6 +18,7 @@ import (
 	"github.com/anchore/grype/grype/presenter/internal"
 	"github.com/anchore/grype/grype/presenter/models"
 	"github.com/anchore/syft/syft/linux"
+	syftPkg "github.com/anchore/syft/syft/pkg"
 	"github.com/anchore/syft/syft/source"
 )
 
@@ -155,3 +158,34 @@ func TestPresenter_Present_NewDocumentSorted(t *testing.T) {
 func redact(content []byte) []byte {
 	return timestampRegexp.ReplaceAll(content, []byte(`"timestamp":""`))
 }
+
+func TestJsonPresenter_NotEvaluated(t *testing.T) {
+	var buffer bytes.Buffer
+
+	pb := models.PresenterConfig{
+		Matches: match.NewMatches(),
+		Context: pkg.Context{Source: &source.Description{}},
+		NotEvaluated: []match.NotEvaluated{
+			{
+				Package: pkg.Package{ID: "conan-pkg", Name: "somelib", Version: "1.0.0", Type: syftPkg.ConanPkg},
+				Matcher: match.ConanMatcher,
+				Reason:  "no known upstream project",
+			},
+		},
+	}
+
+	require.NoError(t, NewPresenter(pb).Present(&buffer))
+
+	var doc models.Document
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &doc))
+	require.Len(t, doc.NotEvaluated, 1)
+	assert.Equal(t, "somelib", doc.NotEvaluated[0].Artifact.Name)
+	assert.Equal(t, string(match.ConanMatcher), doc.NotEvaluated[0].Matcher)
+	assert.Equal(t, "no known upstream project", doc.NotEvaluated[0].Reason)
+
+	// the section is omitted when all packages were evaluated
+	buffer.Reset()
+	pb.NotEvaluated = nil
+	require.NoError(t, NewPresenter(pb).Present(&buffer))
+	assert.NotContains(t, buffer.String(), "notEvaluated")
+}
@@ -13,11 +13,13 @@ import (
 
 // Document represents the JSON document to be presented
 type Document struct {
-	Matches        []Match        `json:"matches"`
-	IgnoredMatches []IgnoredMatch `json:"ignoredMatches,omitempty"`
-	Source         *source        `json:"source"`
-	Distro         distribution   `json:"distro"`
-	Descriptor     descriptor     `json:"descriptor"`
+	Matches         []Match               `json:"matches"`
+	IgnoredMatches  []IgnoredMatch        `json:"ignoredMatches,omitempty"`
+	ResolvedMatches []Match               `json:"resolvedMatches,omitempty"`
+	NotEvaluated    []NotEvaluatedPackage `json:"notEvaluated,omitempty"`
+	Source          *source               `json:"source"`
+	Distro          distribution          `json:"distro"`
+	Descriptor      descriptor            `json:"descriptor"`
 }
 
 // NewDocument creates and populates a new Document struct, representing the populated JSON document.
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+type EPSS struct {
+	CVE        string    `json:"cve"`
+	EPSS       float64   `json:"epss"`
+	Percentile float64   `json:"percentile"`
+	Date       time.Time `json:"date"`
+}
+
+func NewEPSS(metadata *vulnerability.Metadata) []EPSS {
+	if len(metadata.EPSS) == 0 {
+		return nil
+	}
+	epss := make([]EPSS, 0, len(metadata.EPSS))
+	for _, e := range metadata.EPSS {
+		epss = append(epss, EPSS{
+			CVE:        e.CVE,
+			EPSS:       e.EPSS,
+			Percentile: e.Percentile,
+			Date:       e.Date,
+		})
+	}
+	return epss
+}
@@ -8,45 +8,74 @@ type IgnoredMatch struct {
 }
 
 type IgnoreRule struct {
-	Vulnerability    string             `json:"vulnerability,omitempty"`
-	Reason           string             `json:"reason,omitempty"`
-	FixState         string             `json:"fix-state,omitempty"`
-	Package          *IgnoreRulePackage `json:"package,omitempty"`
-	VexStatus        string             `json:"vex-status,omitempty"`
-	VexJustification string             `json:"vex-justification,omitempty"`
-	MatchType        string             `json:"match-type,omitempty"`
+	Vulnerability       string             `json:"vulnerability,omitempty"`
+	Reason              string             `json:"reason,omitempty"`
+	FixState            string             `json:"fix-state,omitempty"`
+	Package             *IgnoreRulePackage `json:"package,omitempty"`
+	VexStatus           string             `json:"vex-status,omitempty"`
+	VexJustification    string             `json:"vex-justification,omitempty"`
+	MatchType           string             `json:"match-type,omitempty"`
+	Severity            *IgnoreRuleRange   `json:"severity,omitempty"`
+	MaxCVSS             float64            `json:"max-cvss,omitempty"`
+	VulnerabilityStatus string             `json:"vulnerability-status,omitempty"`
+	Expires             string             `json:"expires,omitempty"`
+	Owner               string             `json:"owner,omitempty"`
 }
 
 type IgnoreRulePackage struct {
-	Name         string `json:"name,omitempty"`
-	Version      string `json:"version,omitempty"`
-	Type         string `json:"type,omitempty"`
-	Location     string `json:"location,omitempty"`
-	UpstreamName string `json:"upstream-name,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Version           string `json:"version,omitempty"`
+	Type              string `json:"type,omitempty"`
+	Location          string `json:"location,omitempty"`
+	UpstreamName      string `json:"upstream-name,omitempty"`
+	PURL              string `json:"purl,omitempty"`
+	CPE               string `json:"cpe,omitempty"`
+	VersionConstraint string `json:"version-constraint,omitempty"`
+}
+
+type IgnoreRuleRange struct {
+	Min string `json:"min,omitempty"`
+	Max string `json:"max,omitempty"`
 }
 
 func newIgnoreRule(r match.IgnoreRule) IgnoreRule {
 	var ignoreRulePackage *IgnoreRulePackage
 
 	// We'll only set the package part of the rule not to `nil` if there are any values to fill out.
-	if p := r.Package; p.Name != "" || p.Version != "" || p.Type != "" || p.Location != "" {
+	if p := r.Package; p.Name != "" || p.Version != "" || p.Type != "" || p.Location != "" || p.PURL != "" || p.CPE != "" || p.VersionConstraint != "" {
 		ignoreRulePackage = &IgnoreRulePackage{
-			Name:         r.Package.Name,
-			Version:      r.Package.Version,
-			Type:         r.Package.Type,
-			Location:     r.Package.Location,
-			UpstreamName: r.Package.UpstreamName,
+			Name:              r.Package.Name,
+			Version:           r.Package.Version,
+			Type:              r.Package.Type,
+			Location:          r.Package.Location,
+			UpstreamName:      r.Package.UpstreamName,
+			PURL:              r.Package.PURL,
+			CPE:               r.Package.CPE,
+			VersionConstraint: r.Package.VersionConstraint,
+		}
+	}
+
+	var severity *IgnoreRuleRange
+	if !r.Severity.IsEmpty() {
+		severity = &IgnoreRuleRange{
+			Min: r.Severity.Min,
+			Max: r.Severity.Max,
 		}
 	}
 
 	return IgnoreRule{
-		Vulnerability:    r.Vulnerability,
-		Reason:           r.Reason,
-		FixState:         r.FixState,
-		Package:          ignoreRulePackage,
-		VexStatus:        r.VexStatus,
-		VexJustification: r.VexJustification,
-		MatchType:        string(r.MatchType),
+		Vulnerability:       r.Vulnerability,
+		Reason:              r.Reason,
+		FixState:            r.FixState,
+		Package:             ignoreRulePackage,
+		VexStatus:           r.VexStatus,
+		VexJustification:    r.VexJustification,
+		MatchType:           string(r.MatchType),
+		Severity:            severity,
+		MaxCVSS:             r.MaxCVSS,
+		VulnerabilityStatus: r.VulnerabilityStatus,
+		Expires:             r.Expires,
+		Owner:               r.Owner,
 	}
 }
 
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+type KnownExploited struct {
+	CVE                        string     `json:"cve"`
+	VendorProject              string     `json:"vendorProject,omitempty"`
+	Product                    string     `json:"product,omitempty"`
+	DateAdded                  *time.Time `json:"dateAdded,omitempty"`
+	RequiredAction             string     `json:"requiredAction,omitempty"`
+	DueDate                    *time.Time `json:"dueDate,omitempty"`
+	KnownRansomwareCampaignUse string     `json:"knownRansomwareCampaignUse,omitempty"`
+	Notes                      string     `json:"notes,omitempty"`
+	URLs                       []string   `json:"urls,omitempty"`
+}
+
+func NewKnownExploited(metadata *vulnerability.Metadata) []KnownExploited {
+	if len(metadata.KnownExploited) == 0 {
+		return nil
+	}
+	kevs := make([]KnownExploited, 0, len(metadata.KnownExploited))
+	for _, k := range metadata.KnownExploited {
+		kevs = append(kevs, KnownExploited{
+			CVE:                        k.CVE,
+			VendorProject:              k.VendorProject,
+			Product:                    k.Product,
+			DateAdded:                  k.DateAdded,
+			RequiredAction:             k.RequiredAction,
+			DueDate:                    k.DueDate,
+			KnownRansomwareCampaignUse: k.KnownRansomwareCampaignUse,
+			Notes:                      k.Notes,
+			URLs:                       k.URLs,
+		})
+	}
+	return kevs
+}
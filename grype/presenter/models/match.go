@@ -15,6 +15,8 @@ type Match struct {
 	RelatedVulnerabilities []VulnerabilityMetadata `json:"relatedVulnerabilities"`
 	MatchDetails           []MatchDetails          `json:"matchDetails"`
 	Artifact               Package                 `json:"artifact"`
+	ExpiredIgnoreRules     []IgnoreRule            `json:"expiredIgnoreRules,omitempty"` // ignore rules that would have suppressed this match had they not expired
+	FlaggedStatus          string                  `json:"flaggedStatus,omitempty"`      // the status of the vulnerability record when it is called out (e.g. "disputed")
 }
 
 // MatchDetails contains all data that indicates how the result match was found
@@ -23,6 +25,8 @@ type MatchDetails struct {
 	Matcher    string      `json:"matcher"`
 	SearchedBy interface{} `json:"searchedBy"` // The specific attributes that were used to search (other than package name and version) --this indicates "how" the match was made.
 	Found      interface{} `json:"found"`      // The specific attributes on the vulnerability object that were matched with --this indicates "what" was matched on / within.
+	// Reachability indicates if the vulnerable code is present in the scanned artifact (e.g. "reachable", "unreachable" or "unknown")
+	Reachability string `json:"reachability,omitempty"`
 }
 
 func newMatch(m match.Match, p pkg.Package, metadataProvider vulnerability.MetadataProvider) (*Match, error) {
@@ -45,10 +49,11 @@ func newMatch(m match.Match, p pkg.Package, metadataProvider vulnerability.Metad
 	details := make([]MatchDetails, len(m.Details))
 	for idx, d := range m.Details {
 		details[idx] = MatchDetails{
-			Type:       string(d.Type),
-			Matcher:    string(d.Matcher),
-			SearchedBy: d.SearchedBy,
-			Found:      d.Found,
+			Type:         string(d.Type),
+			Matcher:      string(d.Matcher),
+			SearchedBy:   d.SearchedBy,
+			Found:        d.Found,
+			Reachability: string(d.Reachability),
 		}
 	}
 
@@ -57,6 +62,8 @@ func newMatch(m match.Match, p pkg.Package, metadataProvider vulnerability.Metad
 		Artifact:               newPackage(p),
 		RelatedVulnerabilities: relatedVulnerabilities,
 		MatchDetails:           details,
+		ExpiredIgnoreRules:     mapIgnoreRules(m.ExpiredIgnoreRules),
+		FlaggedStatus:          string(m.FlaggedStatus),
 	}, nil
 }
 
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/anchore/grype/grype/match"
+
+// NotEvaluatedPackage is a package that a matcher was responsible for but was unable to search for vulnerabilities,
+// which should not be mistaken for a package without vulnerabilities.
+type NotEvaluatedPackage struct {
+	Artifact Package `json:"artifact"`
+	Matcher  string  `json:"matcher"`
+	Reason   string  `json:"reason"`
+}
+
+func NewNotEvaluatedPackages(notEvaluated []match.NotEvaluated) []NotEvaluatedPackage {
+	var out []NotEvaluatedPackage
+	for _, n := range notEvaluated {
+		out = append(out, NotEvaluatedPackage{
+			Artifact: newPackage(n.Package),
+			Matcher:  string(n.Matcher),
+			Reason:   n.Reason,
+		})
+	}
+	return out
+}
@@ -12,6 +12,8 @@ type PresenterConfig struct {
 	ID               clio.Identification
 	Matches          match.Matches
 	IgnoredMatches   []match.IgnoredMatch
+	ResolvedMatches  []Match // findings from a baseline report that are no longer found
+	NotEvaluated     []match.NotEvaluated
 	Packages         []pkg.Package
 	Context          pkg.Context
 	MetadataProvider vulnerability.MetadataProvider
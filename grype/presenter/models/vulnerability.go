@@ -8,6 +8,8 @@ type Vulnerability struct {
 	VulnerabilityMetadata
 	Fix        Fix        `json:"fix"`
 	Advisories []Advisory `json:"advisories"`
+	// Status is the lifecycle status of the vulnerability record (e.g. "disputed"), which is omitted for active records
+	Status string `json:"status,omitempty"`
 }
 
 type Fix struct {
@@ -24,6 +26,7 @@ func NewVulnerability(vuln vulnerability.Vulnerability, metadata *vulnerability.
 	if metadata == nil {
 		return Vulnerability{
 			VulnerabilityMetadata: NewVulnerabilityMetadata(vuln.ID, vuln.Namespace, metadata),
+			Status:                vulnerabilityStatus(vuln.Status),
 		}
 	}
 
@@ -48,5 +51,13 @@ func NewVulnerability(vuln vulnerability.Vulnerability, metadata *vulnerability.
 			State:    string(vuln.Fix.State),
 		},
 		Advisories: advisories,
+		Status:     vulnerabilityStatus(vuln.Status),
 	}
 }
+
+func vulnerabilityStatus(status vulnerability.Status) string {
+	if status.IsActive() {
+		return ""
+	}
+	return string(status)
+}
@@ -3,13 +3,15 @@ package models
 import "github.com/anchore/grype/grype/vulnerability"
 
 type VulnerabilityMetadata struct {
-	ID          string   `json:"id"`
-	DataSource  string   `json:"dataSource"`
-	Namespace   string   `json:"namespace,omitempty"`
-	Severity    string   `json:"severity,omitempty"`
-	URLs        []string `json:"urls"`
-	Description string   `json:"description,omitempty"`
-	Cvss        []Cvss   `json:"cvss"`
+	ID             string           `json:"id"`
+	DataSource     string           `json:"dataSource"`
+	Namespace      string           `json:"namespace,omitempty"`
+	Severity       string           `json:"severity,omitempty"`
+	URLs           []string         `json:"urls"`
+	Description    string           `json:"description,omitempty"`
+	Cvss           []Cvss           `json:"cvss"`
+	EPSS           []EPSS           `json:"epss,omitempty"`
+	KnownExploited []KnownExploited `json:"knownExploited,omitempty"`
 }
 
 func NewVulnerabilityMetadata(id, namespace string, metadata *vulnerability.Metadata) VulnerabilityMetadata {
@@ -26,13 +28,15 @@ func NewVulnerabilityMetadata(id, namespace string, metadata *vulnerability.Meta
 	}
 
 	return VulnerabilityMetadata{
-		ID:          id,
-		DataSource:  metadata.DataSource,
-		Namespace:   metadata.Namespace,
-		Severity:    metadata.Severity,
-		URLs:        urls,
-		Description: metadata.Description,
-		Cvss:        NewCVSS(metadata),
+		ID:             id,
+		DataSource:     metadata.DataSource,
+		Namespace:      metadata.Namespace,
+		Severity:       metadata.Severity,
+		URLs:           urls,
+		Description:    metadata.Description,
+		Cvss:           NewCVSS(metadata),
+		EPSS:           NewEPSS(metadata),
+		KnownExploited: NewKnownExploited(metadata),
 	}
 }
 
@@ -0,0 +1,263 @@
+package openvex
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	openvex "github.com/openvex/go-vex/pkg/vex"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/grype/grype/vex"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/packageurl-go"
+	"github.com/anchore/syft/syft/source"
+)
+
+// Presenter holds the data for generating an OpenVEX document and implements the presenter.Presenter interface
+type Presenter struct {
+	id             clio.Identification
+	matches        match.Matches
+	ignoredMatches []match.IgnoredMatch
+	src            *source.Description
+}
+
+// NewPresenter is a *Presenter constructor
+func NewPresenter(pb models.PresenterConfig) *Presenter {
+	return &Presenter{
+		id:             pb.ID,
+		matches:        pb.Matches,
+		ignoredMatches: pb.IgnoredMatches,
+		src:            pb.Context.Source,
+	}
+}
+
+// Present creates an OpenVEX document from the scan results, which can be used as the starting point for triage
+func (pres *Presenter) Present(output io.Writer) error {
+	doc, err := pres.toDocument()
+	if err != nil {
+		return err
+	}
+	return doc.ToJSON(output)
+}
+
+// statementKey identifies statements that can be merged into a single statement with several products
+type statementKey struct {
+	vulnerability   string
+	status          openvex.Status
+	justification   openvex.Justification
+	actionStatement string
+	statusNotes     string
+}
+
+func (pres *Presenter) toDocument() (*openvex.VEX, error) {
+	doc := openvex.New()
+	doc.Author = pres.id.Name
+	doc.Tooling = strings.TrimSpace(fmt.Sprintf("%s %s", pres.id.Name, pres.id.Version))
+
+	image := imageComponent(pres.src)
+
+	var keys []statementKey
+	statements := make(map[statementKey]*openvex.Statement)
+	add := func(key statementKey, m match.Match) {
+		product, ok := productFromMatch(image, m)
+		if !ok {
+			return
+		}
+
+		s, exists := statements[key]
+		if !exists {
+			s = &openvex.Statement{
+				Vulnerability:   openvex.Vulnerability{Name: openvex.VulnerabilityID(key.vulnerability)},
+				Status:          key.status,
+				Justification:   key.justification,
+				ActionStatement: key.actionStatement,
+				StatusNotes:     key.statusNotes,
+			}
+			statements[key] = s
+			keys = append(keys, key)
+		}
+		if image != nil && len(s.Products) > 0 {
+			// all packages are subcomponents of the same image product
+			s.Products[0].Subcomponents = append(s.Products[0].Subcomponents, product.Subcomponents...)
+			return
+		}
+		s.Products = append(s.Products, product)
+	}
+
+	for _, m := range pres.matches.Sorted() {
+		status := openvex.StatusAffected
+		action := actionStatement(m)
+		if !hasExactMatch(m) {
+			// CPE based matches are more likely to be false positives, so these need to be looked at before
+			// claiming the product is affected
+			status = openvex.StatusUnderInvestigation
+			action = ""
+		}
+		add(statementKey{
+			vulnerability:   m.Vulnerability.ID,
+			status:          status,
+			actionStatement: action,
+			statusNotes:     statusNotes(m),
+		}, m)
+	}
+
+	for _, m := range pres.ignoredMatches {
+		justification, ok := vexJustification(m)
+		if !ok {
+			// only matches ignored by a VEX justification carry enough information to be asserted as not_affected
+			continue
+		}
+		add(statementKey{
+			vulnerability: m.Vulnerability.ID,
+			status:        openvex.StatusNotAffected,
+			justification: justification,
+		}, m.Match)
+	}
+
+	for _, key := range keys {
+		doc.Statements = append(doc.Statements, *statements[key])
+	}
+
+	if _, err := doc.GenerateCanonicalID(); err != nil {
+		return nil, fmt.Errorf("unable to generate OpenVEX document ID: %w", err)
+	}
+
+	return &doc, nil
+}
+
+// hasExactMatch indicates if the match was found by anything other than a CPE
+func hasExactMatch(m match.Match) bool {
+	for _, d := range m.Details {
+		if d.Type != match.CPEMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// actionStatement describes how to remediate an affected match
+func actionStatement(m match.Match) string {
+	if m.Vulnerability.Fix.State == vulnerability.FixStateFixed && len(m.Vulnerability.Fix.Versions) > 0 {
+		return fmt.Sprintf("Upgrade %s to %s", m.Package.Name, strings.Join(m.Vulnerability.Fix.Versions, " or "))
+	}
+	return openvex.NoActionStatementMsg
+}
+
+// statusNotes calls out the status of the vulnerability record when it is flagged (e.g. disputed)
+func statusNotes(m match.Match) string {
+	if m.FlaggedStatus == "" {
+		return ""
+	}
+	return fmt.Sprintf("the vulnerability record is %s", m.FlaggedStatus)
+}
+
+// vexJustification returns the justification for a match that was ignored as not_affected by a VEX ignore rule. The
+// justification is taken from the VEX statement recorded in the match details, falling back to the justification
+// required by the ignore rule when the statement does not have one.
+func vexJustification(m match.IgnoredMatch) (openvex.Justification, bool) {
+	for _, r := range m.AppliedIgnoreRules {
+		if r.VexStatus != string(openvex.StatusNotAffected) {
+			continue
+		}
+		if j, ok := vex.Justification(m.Match); ok {
+			return j, true
+		}
+		if j := openvex.Justification(r.VexJustification); j.Valid() {
+			return j, true
+		}
+	}
+	return "", false
+}
+
+// productFromMatch returns the OpenVEX product for the package in the match. When the scanned source is an image
+// the package is a subcomponent of the image.
+func productFromMatch(image *openvex.Component, m match.Match) (openvex.Product, bool) {
+	component, ok := packageComponent(m.Package)
+	if !ok {
+		return openvex.Product{}, false
+	}
+
+	if image == nil {
+		return openvex.Product{Component: component}, true
+	}
+
+	return openvex.Product{
+		Component:     *image,
+		Subcomponents: []openvex.Subcomponent{{Component: component}},
+	}, true
+}
+
+// packageComponent identifies the package by package URL, falling back to the first CPE
+func packageComponent(p pkg.Package) (openvex.Component, bool) {
+	if p.PURL != "" {
+		return openvex.Component{
+			ID:          p.PURL,
+			Identifiers: map[openvex.IdentifierType]string{openvex.PURL: p.PURL},
+		}, true
+	}
+
+	if len(p.CPEs) > 0 {
+		c := p.CPEs[0].Attributes.BindToFmtString()
+		return openvex.Component{
+			ID:          c,
+			Identifiers: map[openvex.IdentifierType]string{openvex.CPE23: c},
+		}, true
+	}
+
+	return openvex.Component{}, false
+}
+
+// imageComponent returns an OCI package URL component for the scanned image, or nil when the source is not an
+// image or the image digest is not known
+func imageComponent(src *source.Description) *openvex.Component {
+	if src == nil {
+		return nil
+	}
+
+	metadata, ok := src.Metadata.(source.ImageMetadata)
+	if !ok {
+		return nil
+	}
+
+	var purl string
+	for _, d := range metadata.RepoDigests {
+		ref, err := name.ParseReference(d)
+		if err != nil || !strings.HasPrefix(ref.Identifier(), "sha256:") {
+			continue
+		}
+		repo := ref.Context().RepositoryStr()
+		imageName := repo[strings.LastIndex(repo, "/")+1:]
+		repoURL := strings.TrimSuffix(ref.Context().RegistryStr()+"/"+repo, "/"+imageName)
+		purl = packageurl.NewPackageURL(
+			"oci", "", imageName, ref.Identifier(),
+			packageurl.QualifiersFromMap(map[string]string{"repository_url": repoURL}), "",
+		).String()
+		break
+	}
+
+	if purl == "" && metadata.ManifestDigest != "" {
+		imageName := src.Name
+		if imageName == "" {
+			imageName = metadata.UserInput
+		}
+		if ref, err := name.ParseReference(imageName); err == nil {
+			repo := ref.Context().RepositoryStr()
+			imageName = repo[strings.LastIndex(repo, "/")+1:]
+		}
+		purl = packageurl.NewPackageURL("oci", "", imageName, metadata.ManifestDigest, nil, "").String()
+	}
+
+	if purl == "" {
+		return nil
+	}
+
+	return &openvex.Component{
+		ID:          purl,
+		Identifiers: map[openvex.IdentifierType]string{openvex.PURL: purl},
+	}
+}
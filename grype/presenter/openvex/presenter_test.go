@@ -0,0 +1,221 @@
+package openvex
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	openvex "github.com/openvex/go-vex/pkg/vex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anchore/clio"
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/presenter/internal"
+	"github.com/anchore/grype/grype/presenter/models"
+	grypeOpenvex "github.com/anchore/grype/grype/vex/openvex"
+	"github.com/anchore/grype/grype/vulnerability"
+	"github.com/anchore/syft/syft/source"
+)
+
+func present(t *testing.T, pb models.PresenterConfig) openvex.VEX {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	require.NoError(t, NewPresenter(pb).Present(&buffer))
+
+	var doc openvex.VEX
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &doc))
+	return doc
+}
+
+func TestOpenVEXPresenter_Image(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+
+	_, matches, packages, context, _, _, _ := internal.GenerateAnalysis(t, internal.ImageSource)
+
+	doc := present(t, models.PresenterConfig{
+		ID:       clio.Identification{Name: "grype", Version: "v0.0.0"},
+		Matches:  matches,
+		Packages: packages,
+		Context:  context,
+	})
+
+	assert.Equal(t, openvex.ContextLocator(), doc.Context)
+	assert.Equal(t, "grype", doc.Author)
+	assert.Equal(t, "grype v0.0.0", doc.Tooling)
+	assert.Regexp(t, `^https://openvex.dev/docs/public/vex-[0-9a-f]{64}$`, doc.ID)
+	assert.Equal(t, int64(1700000000), doc.Timestamp.Unix())
+
+	require.Len(t, doc.Statements, 2)
+
+	imageID := "pkg:oci/user-input@sha256%3Aca738abb87a8d58f112d3400ebb079b61ceae7dc290beb34bda735be4b1941d5"
+
+	first := doc.Statements[0]
+	assert.Equal(t, openvex.VulnerabilityID("CVE-1999-0001"), first.Vulnerability.Name)
+	assert.Equal(t, openvex.StatusAffected, first.Status)
+	assert.Equal(t, "Upgrade package-1 to the-next-version", first.ActionStatement)
+	require.Len(t, first.Products, 1)
+	assert.Equal(t, imageID, first.Products[0].ID)
+	require.Len(t, first.Products[0].Subcomponents, 1)
+	// package-1 has no package URL, so it is identified by CPE
+	assert.Equal(t, "cpe:2.3:a:anchore:engine:0.9.2:*:*:python:*:*:*:*", first.Products[0].Subcomponents[0].ID)
+
+	second := doc.Statements[1]
+	assert.Equal(t, openvex.VulnerabilityID("CVE-1999-0002"), second.Vulnerability.Name)
+	assert.Equal(t, openvex.StatusAffected, second.Status)
+	assert.Equal(t, openvex.NoActionStatementMsg, second.ActionStatement)
+	require.Len(t, second.Products, 1)
+	assert.Equal(t, imageID, second.Products[0].ID)
+	require.Len(t, second.Products[0].Subcomponents, 1)
+	assert.Equal(t, "pkg:deb/package-2@2.2.2", second.Products[0].Subcomponents[0].ID)
+
+	for _, s := range doc.Statements {
+		assert.NoError(t, s.Validate())
+	}
+}
+
+// withStatement records a not_affected VEX statement in the match details, as the VEX processor does for the matches
+// it ignores.
+func withStatement(m match.Match, justification openvex.Justification) match.Match {
+	m.Details = append(m.Details, match.Detail{
+		Type: match.ExactDirectMatch,
+		Found: grypeOpenvex.Match{
+			Statement: openvex.Statement{Status: openvex.StatusNotAffected, Justification: justification},
+		},
+		Matcher: match.OpenVexMatcher,
+	})
+	return m
+}
+
+func withFlaggedStatus(m match.Match, status vulnerability.Status) match.Match {
+	m.Vulnerability.Status = status
+	m.FlaggedStatus = status
+	return m
+}
+
+func TestOpenVEXPresenter_Statuses(t *testing.T) {
+	p1 := pkg.Package{ID: "p1", Name: "libcrypto3", Version: "3.0.8-r3", PURL: "pkg:apk/alpine/libcrypto3@3.0.8-r3"}
+	p2 := pkg.Package{ID: "p2", Name: "libssl3", Version: "3.0.8-r3", PURL: "pkg:apk/alpine/libssl3@3.0.8-r3"}
+	p3 := pkg.Package{ID: "p3", Name: "nopurl", Version: "1.0"}
+
+	newMatch := func(id string, p pkg.Package, matchType match.Type) match.Match {
+		return match.Match{
+			Vulnerability: vulnerability.Vulnerability{Reference: vulnerability.Reference{ID: id, Namespace: "nvd:cpe"}},
+			Package:       p,
+			Details:       []match.Detail{{Type: matchType}},
+		}
+	}
+
+	matches := match.NewMatches(
+		newMatch("CVE-2023-0001", p1, match.ExactDirectMatch),
+		newMatch("CVE-2023-0001", p2, match.ExactDirectMatch),
+		newMatch("CVE-2023-0002", p1, match.CPEMatch),
+		// packages that cannot be identified are left out of the document
+		newMatch("CVE-2023-0003", p3, match.ExactDirectMatch),
+		withFlaggedStatus(newMatch("CVE-2023-0009", p2, match.ExactDirectMatch), vulnerability.StatusDisputed),
+	)
+
+	ignored := []match.IgnoredMatch{
+		{
+			Match: newMatch("CVE-2023-0004", p1, match.ExactDirectMatch),
+			AppliedIgnoreRules: []match.IgnoreRule{
+				{Namespace: "vex", VexStatus: "not_affected", VexJustification: "vulnerable_code_not_present"},
+			},
+		},
+		{
+			// not a VEX rule
+			Match:              newMatch("CVE-2023-0005", p1, match.ExactDirectMatch),
+			AppliedIgnoreRules: []match.IgnoreRule{{Vulnerability: "CVE-2023-0005"}},
+		},
+		{
+			// a VEX rule without a justification
+			Match:              newMatch("CVE-2023-0006", p1, match.ExactDirectMatch),
+			AppliedIgnoreRules: []match.IgnoreRule{{Namespace: "vex", VexStatus: "fixed"}},
+		},
+		{
+			// the justification is only in the VEX statement
+			Match:              withStatement(newMatch("CVE-2023-0007", p1, match.ExactDirectMatch), openvex.InlineMitigationsAlreadyExist),
+			AppliedIgnoreRules: []match.IgnoreRule{{Namespace: "vex", VexStatus: "not_affected"}},
+		},
+		{
+			// the justification from the VEX statement takes precedence over the one from the rule
+			Match: withStatement(newMatch("CVE-2023-0008", p2, match.ExactDirectMatch), openvex.VulnerableCodeNotInExecutePath),
+			AppliedIgnoreRules: []match.IgnoreRule{
+				{Namespace: "vex", VexStatus: "not_affected", VexJustification: "vulnerable_code_not_present"},
+			},
+		},
+	}
+
+	doc := present(t, models.PresenterConfig{
+		ID:             clio.Identification{Name: "grype"},
+		Matches:        matches,
+		IgnoredMatches: ignored,
+		Context:        pkg.Context{Source: &source.Description{Metadata: source.DirectoryMetadata{Path: "/some/path"}}},
+	})
+
+	type statement struct {
+		vulnerability string
+		status        openvex.Status
+		justification openvex.Justification
+		statusNotes   string
+		products      []string
+	}
+
+	var actual []statement
+	for _, s := range doc.Statements {
+		var products []string
+		for _, p := range s.Products {
+			assert.Empty(t, p.Subcomponents)
+			products = append(products, p.ID)
+		}
+		actual = append(actual, statement{
+			vulnerability: string(s.Vulnerability.Name),
+			status:        s.Status,
+			justification: s.Justification,
+			statusNotes:   s.StatusNotes,
+			products:      products,
+		})
+		assert.NoError(t, s.Validate())
+	}
+
+	expected := []statement{
+		{
+			vulnerability: "CVE-2023-0001",
+			status:        openvex.StatusAffected,
+			products:      []string{"pkg:apk/alpine/libcrypto3@3.0.8-r3", "pkg:apk/alpine/libssl3@3.0.8-r3"},
+		},
+		{
+			vulnerability: "CVE-2023-0002",
+			status:        openvex.StatusUnderInvestigation,
+			products:      []string{"pkg:apk/alpine/libcrypto3@3.0.8-r3"},
+		},
+		{
+			vulnerability: "CVE-2023-0004",
+			status:        openvex.StatusNotAffected,
+			justification: openvex.VulnerableCodeNotPresent,
+			products:      []string{"pkg:apk/alpine/libcrypto3@3.0.8-r3"},
+		},
+		{
+			vulnerability: "CVE-2023-0007",
+			status:        openvex.StatusNotAffected,
+			justification: openvex.InlineMitigationsAlreadyExist,
+			products:      []string{"pkg:apk/alpine/libcrypto3@3.0.8-r3"},
+		},
+		{
+			vulnerability: "CVE-2023-0008",
+			status:        openvex.StatusNotAffected,
+			justification: openvex.VulnerableCodeNotInExecutePath,
+			products:      []string{"pkg:apk/alpine/libssl3@3.0.8-r3"},
+		},
+		{
+			vulnerability: "CVE-2023-0009",
+			status:        openvex.StatusAffected,
+			statusNotes:   "the vulnerability record is disputed",
+			products:      []string{"pkg:apk/alpine/libssl3@3.0.8-r3"},
+		},
+	}
+
+	assert.Equal(t, expected, actual)
+}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/owenrumney/go-sarif/sarif"
 
@@ -128,12 +129,30 @@ func (pres *Presenter) sarifRules() (out []*sarif.ReportingDescriptor) {
 				descriptor.Properties["purls"] = []string{m.Package.PURL}
 			}
 
+			if meta != nil && len(meta.EPSS) > 0 {
+				descriptor.Properties["epss"] = epssProperties(meta.EPSS)
+			}
+
 			out = append(out, &descriptor)
 		}
 	}
 	return out
 }
 
+// epssProperties renders the EPSS scores as custom rule properties, one entry per CVE
+func epssProperties(scores []vulnerability.EPSS) []map[string]any {
+	var out []map[string]any
+	for _, e := range scores {
+		out = append(out, map[string]any{
+			"cve":        e.CVE,
+			"score":      e.EPSS,
+			"percentile": e.Percentile,
+			"date":       e.Date.Format(time.DateOnly),
+		})
+	}
+	return out
+}
+
 // ruleID creates a unique rule ID for a given match
 func (pres *Presenter) ruleID(m match.Match) string {
 	// TODO if we support configuration, we may want to allow addition of another qualifier such that if multiple
@@ -432,6 +451,9 @@ func (pres *Presenter) resultMessage(m match.Match) sarif.Message {
 	}
 	message := fmt.Sprintf("A %s vulnerability in %s package: %s, version %s was found %s",
 		pres.severityText(m), m.Package.Type, m.Package.Name, m.Package.Version, src)
+	if m.FlaggedStatus != "" {
+		message += fmt.Sprintf(" (the vulnerability record is %s)", m.FlaggedStatus)
+	}
 
 	return sarif.Message{
 		Text: &message,
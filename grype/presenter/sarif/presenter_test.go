@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/anchore/clio"
 	"github.com/anchore/go-testutils"
+	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
 	"github.com/anchore/grype/grype/presenter/internal"
 	"github.com/anchore/grype/grype/presenter/models"
@@ -519,3 +521,46 @@ func Test_imageShortPathName(t *testing.T) {
 		})
 	}
 }
+
+type EPSSMetadataProvider struct{}
+
+func (m *EPSSMetadataProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	return &vulnerability.Metadata{
+		ID:        ref.ID,
+		Namespace: ref.Namespace,
+		Severity:  "high",
+		EPSS: []vulnerability.EPSS{
+			{
+				CVE:        ref.ID,
+				EPSS:       0.42,
+				Percentile: 0.97,
+				Date:       time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}, nil
+}
+
+func Test_sarifRulesEPSS(t *testing.T) {
+	pres := Presenter{
+		results: match.NewMatches(match.Match{
+			Vulnerability: vulnerability.Vulnerability{
+				Reference: vulnerability.Reference{ID: "CVE-1999-0001", Namespace: "nvd:cpe"},
+			},
+			Package: pkg.Package{ID: "pkg-1", Name: "package-1", Version: "1.0.1"},
+		}),
+		metadataProvider: &EPSSMetadataProvider{},
+	}
+
+	rules := pres.sarifRules()
+	require.Len(t, rules, 1)
+
+	expected := []map[string]any{
+		{
+			"cve":        "CVE-1999-0001",
+			"score":      0.42,
+			"percentile": 0.97,
+			"date":       "2025-01-02",
+		},
+	}
+	assert.Equal(t, expected, rules[0].Properties["epss"])
+}
@@ -16,14 +16,23 @@ import (
 )
 
 const (
-	appendSuppressed    = " (suppressed)"
-	appendSuppressedVEX = " (suppressed by VEX)"
+	appendSuppressed         = " (suppressed)"
+	appendSuppressedVEX      = " (suppressed by VEX)"
+	appendSuppressedBaseline = " (suppressed by baseline)"
+	appendExpiredIgnore      = " (expired ignore)"
+)
+
+const (
+	severityColumn = 5
+	epssColumn     = 6
+	kevColumn      = 7
 )
 
 // Presenter is a generic struct for holding fields needed for reporting
 type Presenter struct {
 	results          match.Matches
 	ignoredMatches   []match.IgnoredMatch
+	resolvedMatches  []models.Match
 	packages         []pkg.Package
 	metadataProvider vulnerability.MetadataProvider
 	showSuppressed   bool
@@ -35,6 +44,7 @@ func NewPresenter(pb models.PresenterConfig, showSuppressed bool) *Presenter {
 	return &Presenter{
 		results:          pb.Matches,
 		ignoredMatches:   pb.IgnoredMatches,
+		resolvedMatches:  pb.ResolvedMatches,
 		packages:         pb.Packages,
 		metadataProvider: pb.MetadataProvider,
 		showSuppressed:   showSuppressed,
@@ -46,10 +56,14 @@ func NewPresenter(pb models.PresenterConfig, showSuppressed bool) *Presenter {
 func (pres *Presenter) Present(output io.Writer) error {
 	rows := make([][]string, 0)
 
-	columns := []string{"Name", "Installed", "Fixed-In", "Type", "Vulnerability", "Severity"}
+	columns := []string{"Name", "Installed", "Fixed-In", "Type", "Vulnerability", "Severity", "EPSS", "KEV"}
 	// Generate rows for matching vulnerabilities
 	for m := range pres.results.Enumerate() {
-		row, err := createRow(m, pres.metadataProvider, "")
+		var msg string
+		if len(m.ExpiredIgnoreRules) > 0 {
+			msg = appendExpiredIgnore
+		}
+		row, err := createRow(m, pres.metadataProvider, msg)
 		if err != nil {
 			return err
 		}
@@ -62,8 +76,11 @@ func (pres *Presenter) Present(output io.Writer) error {
 			msg := appendSuppressed
 			if m.AppliedIgnoreRules != nil {
 				for i := range m.AppliedIgnoreRules {
-					if m.AppliedIgnoreRules[i].Namespace == "vex" {
+					switch m.AppliedIgnoreRules[i].Namespace {
+					case "vex":
 						msg = appendSuppressedVEX
+					case "baseline":
+						msg = appendSuppressedBaseline
 					}
 				}
 			}
@@ -77,12 +94,73 @@ func (pres *Presenter) Present(output io.Writer) error {
 	}
 
 	if len(rows) == 0 {
-		_, err := io.WriteString(output, "No vulnerabilities found\n")
-		return err
+		if _, err := io.WriteString(output, "No vulnerabilities found\n"); err != nil {
+			return err
+		}
+		return pres.presentResolved(output)
 	}
 
 	rows = sortRows(removeDuplicateRows(rows))
 
+	// EPSS and KEV data is only available from some database schemas, so only show these columns when there is
+	// something to show (note: columns are removed from the right so that indexes remain valid)
+	for _, column := range []int{kevColumn, epssColumn} {
+		if hasColumnData(rows, column) {
+			continue
+		}
+		columns = removeColumn(columns, column)
+		for i := range rows {
+			rows[i] = removeColumn(rows[i], column)
+		}
+	}
+
+	table := newTable(output, columns)
+
+	if pres.withColor {
+		for _, row := range rows {
+			colors := make([]tablewriter.Colors, len(row))
+			colors[severityColumn] = getSeverityColor(row[severityColumn])
+			table.Rich(row, colors)
+		}
+	} else {
+		table.AppendBulk(rows)
+	}
+
+	table.Render()
+
+	return pres.presentResolved(output)
+}
+
+// presentResolved lists the findings from the baseline that are no longer found, if any
+func (pres *Presenter) presentResolved(output io.Writer) error {
+	if len(pres.resolvedMatches) == 0 {
+		return nil
+	}
+
+	if _, err := io.WriteString(output, "\nResolved since baseline:\n"); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(pres.resolvedMatches))
+	for _, m := range pres.resolvedMatches {
+		rows = append(rows, []string{
+			m.Artifact.Name,
+			m.Artifact.Version,
+			strings.Join(m.Vulnerability.Fix.Versions, ", "),
+			string(m.Artifact.Type),
+			m.Vulnerability.ID,
+			m.Vulnerability.Severity,
+		})
+	}
+
+	table := newTable(output, []string{"Name", "Installed", "Fixed-In", "Type", "Vulnerability", "Severity"})
+	table.AppendBulk(sortRows(removeDuplicateRows(rows)))
+	table.Render()
+
+	return nil
+}
+
+func newTable(output io.Writer, columns []string) *tablewriter.Table {
 	table := tablewriter.NewWriter(output)
 	table.SetHeader(columns)
 	table.SetAutoWrapText(false)
@@ -98,18 +176,7 @@ func (pres *Presenter) Present(output io.Writer) error {
 	table.SetTablePadding("  ")
 	table.SetNoWhiteSpace(true)
 
-	if pres.withColor {
-		for _, row := range rows {
-			severityColor := getSeverityColor(row[len(row)-1])
-			table.Rich(row, []tablewriter.Colors{{}, {}, {}, {}, {}, severityColor})
-		}
-	} else {
-		table.AppendBulk(rows)
-	}
-
-	table.Render()
-
-	return nil
+	return table
 }
 
 func supportsColor() bool {
@@ -125,7 +192,7 @@ func sortRows(rows [][]string) [][]string {
 			fix         = 2
 			packageType = 3
 			vuln        = 4
-			sev         = 5
+			sev         = severityColumn
 		)
 		// name, version, type, severity, vulnerability
 		// > is for numeric sorting like severity or year/number of vulnerability
@@ -153,6 +220,22 @@ func sortRows(rows [][]string) [][]string {
 	return rows
 }
 
+func hasColumnData(rows [][]string, column int) bool {
+	for _, row := range rows {
+		if column < len(row) && row[column] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func removeColumn(row []string, column int) []string {
+	if column >= len(row) {
+		return row
+	}
+	return append(row[:column:column], row[column+1:]...)
+}
+
 func removeDuplicateRows(items [][]string) [][]string {
 	seen := map[string][]string{}
 	var result [][]string
@@ -171,7 +254,7 @@ func removeDuplicateRows(items [][]string) [][]string {
 }
 
 func createRow(m match.Match, metadataProvider vulnerability.MetadataProvider, severitySuffix string) ([]string, error) {
-	var severity string
+	var severity, epss, kev string
 
 	metadata, err := metadataProvider.VulnerabilityMetadata(m.Vulnerability.Reference)
 	if err != nil {
@@ -180,6 +263,10 @@ func createRow(m match.Match, metadataProvider vulnerability.MetadataProvider, s
 
 	if metadata != nil {
 		severity = metadata.Severity + severitySuffix
+		epss = epssText(metadata.EPSS)
+		if len(metadata.KnownExploited) > 0 {
+			kev = "yes"
+		}
 	}
 
 	fixVersion := strings.Join(m.Vulnerability.Fix.Versions, ", ")
@@ -190,12 +277,51 @@ func createRow(m match.Match, metadataProvider vulnerability.MetadataProvider, s
 		fixVersion = ""
 	}
 
-	return []string{m.Package.Name, m.Package.Version, fixVersion, string(m.Package.Type), m.Vulnerability.ID, severity}, nil
+	vulnID := m.Vulnerability.ID
+	if m.FlaggedStatus != "" {
+		vulnID = fmt.Sprintf("%s (%s)", vulnID, m.FlaggedStatus)
+	}
+
+	return []string{m.Package.Name, m.Package.Version, fixVersion, string(m.Package.Type), vulnID, severity, epss, kev}, nil
+}
+
+// epssText renders the EPSS score (as a probability) and percentile of the highest scoring CVE, e.g. "42.0% (97th)"
+func epssText(scores []vulnerability.EPSS) string {
+	if len(scores) == 0 {
+		return ""
+	}
+	top := scores[0]
+	for _, e := range scores[1:] {
+		if e.Percentile > top.Percentile {
+			top = e
+		}
+	}
+	return fmt.Sprintf("%.1f%% (%s)", top.EPSS*100, ordinal(int(top.Percentile*100)))
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 100 {
+	case 11, 12, 13:
+	default:
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
 }
 
 func getSeverityColor(severity string) tablewriter.Colors {
 	severityFontType, severityColor := tablewriter.Normal, tablewriter.Normal
 
+	// ignore any suffix describing the status of the match (e.g. "High (expired ignore)")
+	severity, _, _ = strings.Cut(severity, " (")
+
 	switch strings.ToLower(severity) {
 	case "critical":
 		severityFontType = tablewriter.Bold
@@ -52,14 +52,14 @@ func TestCreateRow(t *testing.T) {
 			match:          match1,
 			severitySuffix: "",
 			expectedErr:    nil,
-			expectedRow:    []string{match1.Package.Name, match1.Package.Version, "", string(match1.Package.Type), match1.Vulnerability.ID, "Low"},
+			expectedRow:    []string{match1.Package.Name, match1.Package.Version, "", string(match1.Package.Type), match1.Vulnerability.ID, "Low", "", ""},
 		},
 		{
 			name:           "create row for suppressed vulnerability",
 			match:          match1,
 			severitySuffix: appendSuppressed,
 			expectedErr:    nil,
-			expectedRow:    []string{match1.Package.Name, match1.Package.Version, "", string(match1.Package.Type), match1.Vulnerability.ID, "Low (suppressed)"},
+			expectedRow:    []string{match1.Package.Name, match1.Package.Version, "", string(match1.Package.Type), match1.Vulnerability.ID, "Low (suppressed)", "", ""},
 		},
 	}
 
@@ -231,3 +231,219 @@ func TestDisplaysIgnoredMatches(t *testing.T) {
 	actual := buffer.String()
 	snaps.MatchSnapshot(t, actual)
 }
+
+type stubMetadataProvider map[string]vulnerability.Metadata
+
+func (p stubMetadataProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	m := p[ref.ID]
+	m.ID = ref.ID
+	m.Namespace = ref.Namespace
+	m.Severity = "High"
+	return &m, nil
+}
+
+func TestTablePresenter_OptionalColumns(t *testing.T) {
+	p := pkg.Package{
+		ID:      "package-1-id",
+		Name:    "package-1",
+		Version: "1.0.1",
+		Type:    syftPkg.DebPkg,
+	}
+	newMatch := func(id string) match.Match {
+		return match.Match{
+			Vulnerability: vulnerability.Vulnerability{
+				Reference: vulnerability.Reference{ID: id, Namespace: "source-1"},
+			},
+			Package: p,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		metadata stubMetadataProvider
+		expected string
+	}{
+		{
+			name: "show EPSS column when there is EPSS data",
+			metadata: stubMetadataProvider{
+				"CVE-1999-0001": {
+					EPSS: []vulnerability.EPSS{{CVE: "CVE-1999-0001", EPSS: 0.42, Percentile: 0.971}},
+				},
+			},
+			expected: "NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY  SEVERITY  EPSS         \n" +
+				"package-1  1.0.1                deb   CVE-1999-0002  High                    \n" +
+				"package-1  1.0.1                deb   CVE-1999-0001  High      42.0% (97th)  \n",
+		},
+		{
+			name: "show KEV column when there is KEV data",
+			metadata: stubMetadataProvider{
+				"CVE-1999-0002": {
+					KnownExploited: []vulnerability.KnownExploited{{CVE: "CVE-1999-0002"}},
+				},
+			},
+			expected: "NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY  SEVERITY  KEV \n" +
+				"package-1  1.0.1                deb   CVE-1999-0002  High      yes  \n" +
+				"package-1  1.0.1                deb   CVE-1999-0001  High           \n",
+		},
+		{
+			name: "show EPSS and KEV columns",
+			metadata: stubMetadataProvider{
+				"CVE-1999-0001": {
+					EPSS: []vulnerability.EPSS{{CVE: "CVE-1999-0001", EPSS: 0.42, Percentile: 0.971}},
+				},
+				"CVE-1999-0002": {
+					KnownExploited: []vulnerability.KnownExploited{{CVE: "CVE-1999-0002"}},
+				},
+			},
+			expected: "NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY  SEVERITY  EPSS          KEV \n" +
+				"package-1  1.0.1                deb   CVE-1999-0002  High                    yes  \n" +
+				"package-1  1.0.1                deb   CVE-1999-0001  High      42.0% (97th)       \n",
+		},
+		{
+			name:     "hide EPSS and KEV columns when there is no data",
+			metadata: stubMetadataProvider{},
+			expected: "NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY  SEVERITY \n" +
+				"package-1  1.0.1                deb   CVE-1999-0002  High      \n" +
+				"package-1  1.0.1                deb   CVE-1999-0001  High      \n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pres := NewPresenter(models.PresenterConfig{
+				Matches:          match.NewMatches(newMatch("CVE-1999-0001"), newMatch("CVE-1999-0002")),
+				MetadataProvider: tt.metadata,
+			}, false)
+			pres.withColor = false
+
+			var buffer bytes.Buffer
+			require.NoError(t, pres.Present(&buffer))
+			assert.Equal(t, tt.expected, buffer.String())
+		})
+	}
+}
+
+func TestTablePresenter_ResolvedMatches(t *testing.T) {
+	resolved := models.Match{
+		Artifact: models.Package{Name: "package-2", Version: "2.0.0", Type: "npm"},
+	}
+	resolved.Vulnerability.ID = "CVE-1999-0003"
+	resolved.Vulnerability.Severity = "Medium"
+	resolved.Vulnerability.Fix.Versions = []string{"2.0.1"}
+
+	pres := NewPresenter(models.PresenterConfig{
+		Matches:          match.NewMatches(),
+		ResolvedMatches:  []models.Match{resolved},
+		MetadataProvider: stubMetadataProvider{},
+	}, false)
+	pres.withColor = false
+
+	var buffer bytes.Buffer
+	require.NoError(t, pres.Present(&buffer))
+
+	expected := "No vulnerabilities found\n" +
+		"\n" +
+		"Resolved since baseline:\n" +
+		"NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY  SEVERITY \n" +
+		"package-2  2.0.0      2.0.1     npm   CVE-1999-0003  Medium    \n"
+	assert.Equal(t, expected, buffer.String())
+}
+
+func TestTablePresenter_ExpiredIgnoreRules(t *testing.T) {
+	p := pkg.Package{
+		ID:      "package-1-id",
+		Name:    "package-1",
+		Version: "1.0.1",
+		Type:    syftPkg.DebPkg,
+	}
+	expired := match.Match{
+		Vulnerability: vulnerability.Vulnerability{
+			Reference: vulnerability.Reference{ID: "CVE-1999-0001", Namespace: "source-1"},
+		},
+		Package:            p,
+		ExpiredIgnoreRules: []match.IgnoreRule{{Vulnerability: "CVE-1999-0001", Expires: "2020-01-01"}},
+	}
+
+	pres := NewPresenter(models.PresenterConfig{
+		Matches:          match.NewMatches(expired),
+		MetadataProvider: stubMetadataProvider{},
+	}, false)
+	pres.withColor = false
+
+	var buffer bytes.Buffer
+	require.NoError(t, pres.Present(&buffer))
+
+	expected := "NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY  SEVERITY              \n" +
+		"package-1  1.0.1                deb   CVE-1999-0001  High (expired ignore)  \n"
+	assert.Equal(t, expected, buffer.String())
+}
+
+func TestEpssText(t *testing.T) {
+	tests := []struct {
+		name     string
+		scores   []vulnerability.EPSS
+		expected string
+	}{
+		{
+			name:     "no scores",
+			expected: "",
+		},
+		{
+			name: "highest percentile wins",
+			scores: []vulnerability.EPSS{
+				{CVE: "CVE-1", EPSS: 0.001, Percentile: 0.12},
+				{CVE: "CVE-2", EPSS: 0.5, Percentile: 0.993},
+			},
+			expected: "50.0% (99th)",
+		},
+		{
+			name: "ordinal suffixes",
+			scores: []vulnerability.EPSS{
+				{CVE: "CVE-1", EPSS: 0.0123, Percentile: 0.52},
+			},
+			expected: "1.2% (52nd)",
+		},
+		{
+			name: "teens use th",
+			scores: []vulnerability.EPSS{
+				{CVE: "CVE-1", EPSS: 0.0001, Percentile: 0.11},
+			},
+			expected: "0.0% (11th)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, epssText(tt.scores))
+		})
+	}
+}
+
+func TestTablePresenter_FlaggedStatus(t *testing.T) {
+	disputed := match.Match{
+		Vulnerability: vulnerability.Vulnerability{
+			Reference: vulnerability.Reference{ID: "CVE-1999-0001", Namespace: "source-1"},
+			Status:    vulnerability.StatusDisputed,
+		},
+		Package: pkg.Package{
+			ID:      "package-1-id",
+			Name:    "package-1",
+			Version: "1.0.1",
+			Type:    syftPkg.DebPkg,
+		},
+		FlaggedStatus: vulnerability.StatusDisputed,
+	}
+
+	pres := NewPresenter(models.PresenterConfig{
+		Matches:          match.NewMatches(disputed),
+		MetadataProvider: stubMetadataProvider{},
+	}, false)
+	pres.withColor = false
+
+	var buffer bytes.Buffer
+	require.NoError(t, pres.Present(&buffer))
+
+	expected := "NAME       INSTALLED  FIXED-IN  TYPE  VULNERABILITY             SEVERITY \n" +
+		"package-1  1.0.1                deb   CVE-1999-0001 (disputed)  High      \n"
+	assert.Equal(t, expected, buffer.String())
+}
@@ -0,0 +1,69 @@
+package version
+
+import (
+	"fmt"
+)
+
+type composerConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newComposerConstraint(raw string) (composerConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return composerConstraint{}, nil
+	}
+
+	constraints, err := newConstraintExpression(raw, newComposerComparator)
+	if err != nil {
+		return composerConstraint{}, fmt.Errorf("unable to parse composer constraint phrase: %w", err)
+	}
+
+	return composerConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+func newComposerComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newComposerVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c composerConstraint) supported(format Format) bool {
+	return format == ComposerFormat
+}
+
+func (c composerConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(composer) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.composerVer == nil {
+		return false, fmt.Errorf("no rich composer version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c composerConstraint) String() string {
+	if c.raw == "" {
+		return "none (composer)"
+	}
+	return fmt.Sprintf("%s (composer)", c.raw)
+}
@@ -0,0 +1,39 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionComposerConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "2.3.1", constraint: "", satisfied: true},
+		// typical GHSA ranges
+		{version: "5.4.1", constraint: ">= 5.0.0, < 5.4.2", satisfied: true},
+		{version: "5.4.2", constraint: ">= 5.0.0, < 5.4.2", satisfied: false},
+		{version: "v5.4.1", constraint: ">= 5.0.0, < 5.4.2", satisfied: true},
+		{version: "4.4.49", constraint: ">= 2.0.0, < 4.4.50 || >= 5.0.0, < 5.4.2", satisfied: true},
+		{version: "4.4.50", constraint: ">= 2.0.0, < 4.4.50 || >= 5.0.0, < 5.4.2", satisfied: false},
+		// stabilities
+		{version: "5.4.2-RC1", constraint: "< 5.4.2", satisfied: true},
+		{version: "5.4.2-beta3", constraint: "< 5.4.2-RC1", satisfied: true},
+		{version: "5.4.2-patch1", constraint: "<= 5.4.2", satisfied: false},
+		{version: "5.4.2-dev", constraint: ">= 5.4.2-alpha1", satisfied: false},
+		// numeric branches
+		{version: "5.4.x-dev", constraint: "< 5.4.2", satisfied: false},
+		{version: "5.3.x-dev", constraint: "< 5.4.2", satisfied: true},
+		// normalization
+		{version: "5.4", constraint: "= 5.4.0.0", satisfied: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newComposerConstraint(test.constraint)
+			assert.NoError(t, err, "unexpected error from newComposerConstraint: %v", err)
+
+			test.assertVersionConstraint(t, ComposerFormat, constraint)
+		})
+	}
+}
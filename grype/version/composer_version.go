@@ -0,0 +1,193 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*composerVersion)(nil)
+
+// composer stabilities, in ascending order (see https://getcomposer.org/doc/articles/versions.md#stabilities)
+const (
+	composerStabilityDev = iota
+	composerStabilityAlpha
+	composerStabilityBeta
+	composerStabilityRC
+	composerStabilityStable
+	composerStabilityPatch
+)
+
+// composerBranchNumber is the number composer substitutes for wildcard parts of numeric branches (e.g. "2.1.x-dev")
+const composerBranchNumber = 9999999
+
+var (
+	// derived from composer's VersionParser::normalize(), see
+	// https://github.com/composer/semver/blob/main/src/VersionParser.php
+	composerVersionPattern = regexp.MustCompile(`(?i)^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?` +
+		`(?:[._-]?(stable|beta|b|rc|alpha|a|patch|pl|p)((?:[.-]?\d+)*))?` +
+		`([.-]?dev)?$`)
+	composerBranchPattern = regexp.MustCompile(`(?i)^v?(\d+)(?:\.(\d+|[x*]))?(?:\.(\d+|[x*]))?(?:\.(\d+|[x*]))?[.-]?dev$`)
+	composerDigits        = regexp.MustCompile(`\d+`)
+)
+
+// composerVersion is a normalized PHP Composer package version (e.g. "v1.2-RC1" is normalized to "1.2.0.0-RC1")
+type composerVersion struct {
+	raw string
+	// numbers are always four parts (composer pads versions with zeros)
+	numbers []int
+	// stability is the release stability, stable releases without a suffix are composerStabilityStable
+	stability int
+	// stabilityNumbers are the numbers that follow the stability suffix (e.g. "RC2" -> [2])
+	stabilityNumbers []int
+	// dev indicates a development version of the given release (e.g. "1.0.0-beta1-dev" or numeric branches "1.x-dev")
+	dev bool
+}
+
+func newComposerVersion(raw string) (*composerVersion, error) {
+	normalized := strings.TrimSpace(raw)
+
+	// strip inline aliases ("1.0.x-dev as 1.0.0") and source references ("dev-main#abc123")
+	if before, _, found := strings.Cut(normalized, " as "); found {
+		normalized = strings.TrimSpace(before)
+	}
+	if before, _, found := strings.Cut(normalized, "#"); found {
+		normalized = before
+	}
+
+	lower := strings.ToLower(normalized)
+	if strings.HasPrefix(lower, "dev-") || lower == "master" || lower == "trunk" || lower == "default" {
+		// named branches (e.g. "dev-main") cannot be ordered relative to releases
+		return nil, fmt.Errorf("%w: composer branch %q", ErrUnsupportedVersion, raw)
+	}
+
+	if m := composerVersionPattern.FindStringSubmatch(normalized); m != nil {
+		v := &composerVersion{
+			raw:       raw,
+			numbers:   composerNumbers(m[1:5], 0),
+			stability: composerStability(m[5]),
+			dev:       m[7] != "",
+		}
+		for _, n := range composerDigits.FindAllString(m[6], -1) {
+			i, err := strconv.Atoi(n)
+			if err != nil {
+				return nil, fmt.Errorf("invalid composer version %q: %w", raw, err)
+			}
+			v.stabilityNumbers = append(v.stabilityNumbers, i)
+		}
+		if v.dev && m[5] == "" {
+			// a bare "-dev" suffix is the dev stability of the release (e.g. "1.0.0-dev")
+			v.stability = composerStabilityDev
+			v.dev = false
+		}
+		return v, nil
+	}
+
+	if m := composerBranchPattern.FindStringSubmatch(normalized); m != nil {
+		// numeric branches (e.g. "2.1.x-dev") are normalized to the highest version on the branch ("2.1.9999999.9999999-dev")
+		return &composerVersion{
+			raw:       raw,
+			numbers:   composerNumbers(m[1:5], composerBranchNumber),
+			stability: composerStabilityDev,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unable to parse composer version: %q", raw)
+}
+
+// composerNumbers returns the four numeric parts of the version, where missing parts are padded with the given value
+// (and wildcard parts are always the branch number)
+func composerNumbers(parts []string, padding int) []int {
+	numbers := make([]int, len(parts))
+	for i, p := range parts {
+		switch p {
+		case "":
+			numbers[i] = padding
+		case "x", "X", "*":
+			numbers[i] = composerBranchNumber
+		default:
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				// the pattern only allows digits, this could only be out of range
+				n = composerBranchNumber
+			}
+			numbers[i] = n
+		}
+	}
+	return numbers
+}
+
+func composerStability(s string) int {
+	switch strings.ToLower(s) {
+	case "alpha", "a":
+		return composerStabilityAlpha
+	case "beta", "b":
+		return composerStabilityBeta
+	case "rc":
+		return composerStabilityRC
+	case "patch", "pl", "p":
+		return composerStabilityPatch
+	default:
+		return composerStabilityStable
+	}
+}
+
+func (v *composerVersion) Compare(other *Version) (int, error) {
+	if other.Format != ComposerFormat {
+		return -1, fmt.Errorf("unable to compare composer to given format: %s", other.Format)
+	}
+	if other.rich.composerVer == nil {
+		return -1, fmt.Errorf("given empty composerVersion object")
+	}
+
+	return other.rich.composerVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v composerVersion) compare(v2 composerVersion) int {
+	if c := compareComposerNumbers(v.numbers, v2.numbers); c != 0 {
+		return c
+	}
+
+	if v.stability != v2.stability {
+		if v.stability < v2.stability {
+			return -1
+		}
+		return 1
+	}
+
+	if c := compareComposerNumbers(v.stabilityNumbers, v2.stabilityNumbers); c != 0 {
+		return c
+	}
+
+	switch {
+	case v.dev == v2.dev:
+		return 0
+	case v.dev:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareComposerNumbers compares two lists of numbers part by part, where a list that is a prefix of the other is lower
+// (e.g. "beta" < "beta1")
+func compareComposerNumbers(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
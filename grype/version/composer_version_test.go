@@ -0,0 +1,76 @@
+package version
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionComposer(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.0", "1.0.0.0", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"1.0.0.1", "1.0.0", 1},
+		// stabilities: dev < alpha < beta < RC < stable < patch
+		{"1.0.0-dev", "1.0.0-alpha1", -1},
+		{"1.0.0-alpha2", "1.0.0-beta1", -1},
+		{"1.0.0-beta2", "1.0.0-RC1", -1},
+		{"1.0.0-RC3", "1.0.0", -1},
+		{"1.0.0", "1.0.0-patch1", -1},
+		{"1.0.0-pl2", "1.0.0-patch1", 1},
+		{"1.0.0-stable", "1.0.0", 0},
+		// short and case-insensitive stability suffixes
+		{"1.0.0-b2", "1.0.0-beta2", 0},
+		{"1.0.0-a1", "1.0.0-ALPHA1", 0},
+		{"1.0.0-rc1", "1.0.0-RC1", 0},
+		{"1.0.0RC1", "1.0.0-RC.1", 0},
+		// stability numbers
+		{"1.0.0-beta", "1.0.0-beta1", -1},
+		{"1.0.0-beta10", "1.0.0-beta9", 1},
+		{"1.0.0-RC1.2", "1.0.0-RC1.1", 1},
+		{"1.0.0-beta1-dev", "1.0.0-beta1", -1},
+		// numeric branches are the highest version on the branch
+		{"2.1.x-dev", "2.1.99", 1},
+		{"2.1.x-dev", "2.2.0", -1},
+		{"2.x-dev", "2.99.0", 1},
+		// inline aliases and references
+		{"1.0.x-dev as 1.0.0", "1.0.99", 1},
+		{"1.2.3#abcdef", "1.2.3", 0},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newComposerVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newComposerVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewComposerVersion_Unsupported(t *testing.T) {
+	for _, raw := range []string{"dev-main", "dev-feature/foo#abcdef", "master"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newComposerVersion(raw)
+			assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+		})
+	}
+
+	_, err := newComposerVersion("not a version")
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrUnsupportedVersion))
+}
@@ -31,6 +31,22 @@ func GetConstraint(constStr string, format Format) (Constraint, error) {
 		return newPortageConstraint(constStr)
 	case JVMFormat:
 		return newJvmConstraint(constStr)
+	case ComposerFormat:
+		return newComposerConstraint(constStr)
+	case PubFormat:
+		return newPubConstraint(constStr)
+	case HexFormat:
+		return newHexConstraint(constStr)
+	case PacmanFormat:
+		return newPacmanConstraint(constStr)
+	case NuGetFormat:
+		return newNuGetConstraint(constStr)
+	case NpmFormat:
+		return newNpmConstraint(constStr)
+	case KernelFormat:
+		return newKernelConstraint(constStr)
+	case NixFormat:
+		return newNixConstraint(constStr)
 	case UnknownFormat:
 		return newFuzzyConstraint(constStr, "unknown")
 	}
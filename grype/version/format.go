@@ -20,6 +20,14 @@ const (
 	PortageFormat
 	GolangFormat
 	JVMFormat
+	ComposerFormat
+	PubFormat
+	HexFormat
+	PacmanFormat
+	NuGetFormat
+	NpmFormat
+	KernelFormat
+	NixFormat
 )
 
 type Format int
@@ -37,6 +45,14 @@ var formatStr = []string{
 	"Portage",
 	"Go",
 	"JVM",
+	"Composer",
+	"Pub",
+	"Hex",
+	"Pacman",
+	"NuGet",
+	"npm",
+	"Kernel",
+	"Nix",
 }
 
 var Formats = []Format{
@@ -51,6 +67,14 @@ var Formats = []Format{
 	PortageFormat,
 	GolangFormat,
 	JVMFormat,
+	ComposerFormat,
+	PubFormat,
+	HexFormat,
+	PacmanFormat,
+	NuGetFormat,
+	NpmFormat,
+	KernelFormat,
+	NixFormat,
 }
 
 func ParseFormat(userStr string) Format {
@@ -77,6 +101,22 @@ func ParseFormat(userStr string) Format {
 		return PortageFormat
 	case strings.ToLower(JVMFormat.String()), "jvm", "jre", "jdk", "openjdk", "jep223":
 		return JVMFormat
+	case strings.ToLower(ComposerFormat.String()), "php-composer", "php":
+		return ComposerFormat
+	case strings.ToLower(PubFormat.String()), "dart-pub", "dart":
+		return PubFormat
+	case strings.ToLower(HexFormat.String()), "erlang", "elixir":
+		return HexFormat
+	case strings.ToLower(PacmanFormat.String()), "alpm", "arch", "archlinux":
+		return PacmanFormat
+	case strings.ToLower(NuGetFormat.String()), "dotnet":
+		return NuGetFormat
+	case strings.ToLower(NpmFormat.String()), "node", "javascript":
+		return NpmFormat
+	case strings.ToLower(KernelFormat.String()), "linux-kernel", "linux":
+		return KernelFormat
+	case strings.ToLower(NixFormat.String()), "nixpkgs":
+		return NixFormat
 	}
 	return UnknownFormat
 }
@@ -101,6 +141,22 @@ func FormatFromPkg(p pkg.Package) Format {
 		return PortageFormat
 	case syftPkg.GoModulePkg:
 		return GolangFormat
+	case syftPkg.PhpComposerPkg:
+		return ComposerFormat
+	case syftPkg.DartPubPkg:
+		return PubFormat
+	case syftPkg.HexPkg:
+		return HexFormat
+	case syftPkg.AlpmPkg:
+		return PacmanFormat
+	case syftPkg.DotnetPkg:
+		return NuGetFormat
+	case syftPkg.NpmPkg:
+		return NpmFormat
+	case syftPkg.LinuxKernelPkg:
+		return KernelFormat
+	case syftPkg.NixPkg:
+		return NixFormat
 	}
 
 	if pkg.IsJvmPackage(p) {
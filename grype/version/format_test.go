@@ -37,6 +37,50 @@ func TestParseFormat(t *testing.T) {
 			input:  "semver",
 			format: SemanticFormat,
 		},
+		{
+			input:  "composer",
+			format: ComposerFormat,
+		},
+		{
+			input:  "pub",
+			format: PubFormat,
+		},
+		{
+			input:  "hex",
+			format: HexFormat,
+		},
+		{
+			input:  "erlang",
+			format: HexFormat,
+		},
+		{
+			input:  "pacman",
+			format: PacmanFormat,
+		},
+		{
+			input:  "alpm",
+			format: PacmanFormat,
+		},
+		{
+			input:  "nuget",
+			format: NuGetFormat,
+		},
+		{
+			input:  "NuGet",
+			format: NuGetFormat,
+		},
+		{
+			input:  "npm",
+			format: NpmFormat,
+		},
+		{
+			input:  "kernel",
+			format: KernelFormat,
+		},
+		{
+			input:  "nix",
+			format: NixFormat,
+		},
 	}
 
 	for _, test := range tests {
@@ -116,6 +160,62 @@ func TestFormatFromPkgType(t *testing.T) {
 			},
 			format: JVMFormat,
 		},
+		{
+			name: "composer",
+			p: pkg.Package{
+				Type: syftPkg.PhpComposerPkg,
+			},
+			format: ComposerFormat,
+		},
+		{
+			name: "dart pub",
+			p: pkg.Package{
+				Type: syftPkg.DartPubPkg,
+			},
+			format: PubFormat,
+		},
+		{
+			name: "hex",
+			p: pkg.Package{
+				Type: syftPkg.HexPkg,
+			},
+			format: HexFormat,
+		},
+		{
+			name: "alpm",
+			p: pkg.Package{
+				Type: syftPkg.AlpmPkg,
+			},
+			format: PacmanFormat,
+		},
+		{
+			name: "dotnet",
+			p: pkg.Package{
+				Type: syftPkg.DotnetPkg,
+			},
+			format: NuGetFormat,
+		},
+		{
+			name: "npm",
+			p: pkg.Package{
+				Type: syftPkg.NpmPkg,
+			},
+			format: NpmFormat,
+		},
+		{
+			name: "linux kernel",
+			p: pkg.Package{
+				Type: syftPkg.LinuxKernelPkg,
+			},
+			format: KernelFormat,
+		},
+		{
+			name: "nix",
+			p: pkg.Package{
+				Type: syftPkg.NixPkg,
+			},
+			format: NixFormat,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,141 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	hexOrPattern   = regexp.MustCompile(`\s+or\s+|\|\|`)
+	hexAndPattern  = regexp.MustCompile(`\s+and\s+|,`)
+	hexUnitPattern = regexp.MustCompile(`^\s*(~>|==|!=|>=|<=|>|<|=)?\s*(\S+)\s*$`)
+)
+
+type hexConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newHexConstraint(raw string) (hexConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return hexConstraint{}, nil
+	}
+
+	phrase, err := normalizeHexRequirement(raw)
+	if err != nil {
+		return hexConstraint{}, fmt.Errorf("unable to parse hex constraint phrase: %w", err)
+	}
+
+	constraints, err := newConstraintExpression(phrase, newHexComparator)
+	if err != nil {
+		return hexConstraint{}, fmt.Errorf("unable to parse hex constraint phrase: %w", err)
+	}
+
+	return hexConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+// normalizeHexRequirement rewrites an Elixir version requirement (e.g. "~> 2.1 and != 2.1.3 or == 3.0.0") into a
+// constraint expression, where:
+//   - "and" / "or" are the "," / "||" operators (which may also be used directly, as in GHSA ranges)
+//   - "~> 2.1" is ">= 2.1.0, < 3.0.0-0" and "~> 2.1.2" is ">= 2.1.2, < 2.2.0-0"
+//   - "!= 2.1.3" splits the group it is in into "< 2.1.3" and "> 2.1.3" alternatives
+func normalizeHexRequirement(raw string) (string, error) {
+	var orGroups []string
+	for _, orPart := range hexOrPattern.Split(raw, -1) {
+		groups := [][]string{nil}
+		for _, andPart := range hexAndPattern.Split(orPart, -1) {
+			m := hexUnitPattern.FindStringSubmatch(andPart)
+			if m == nil {
+				return "", fmt.Errorf("invalid hex requirement %q", andPart)
+			}
+			op, ver := m[1], m[2]
+
+			var units []string
+			switch op {
+			case "~>":
+				v, err := newHexVersion(ver)
+				if err != nil {
+					return "", err
+				}
+				upper := fmt.Sprintf("%d.0.0-0", v.numbers[0]+1)
+				if v.hasPatch {
+					upper = fmt.Sprintf("%d.%d.0-0", v.numbers[0], v.numbers[1]+1)
+				}
+				units = []string{">= " + ver, "< " + upper}
+			case "!=":
+				var split [][]string
+				for _, g := range groups {
+					split = append(split, appendUnits(g, "< "+ver), appendUnits(g, "> "+ver))
+				}
+				groups = split
+				continue
+			case "==", "=", "":
+				units = []string{"= " + ver}
+			default:
+				units = []string{op + " " + ver}
+			}
+
+			for i := range groups {
+				groups[i] = appendUnits(groups[i], units...)
+			}
+		}
+
+		for _, g := range groups {
+			orGroups = append(orGroups, strings.Join(g, ", "))
+		}
+	}
+
+	return strings.Join(orGroups, " || "), nil
+}
+
+// appendUnits returns a new group with the given units appended (without modifying the given group)
+func appendUnits(group []string, units ...string) []string {
+	return append(append([]string{}, group...), units...)
+}
+
+func newHexComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newHexVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c hexConstraint) supported(format Format) bool {
+	return format == HexFormat
+}
+
+func (c hexConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(hex) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.hexVer == nil {
+		return false, fmt.Errorf("no rich hex version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c hexConstraint) String() string {
+	if c.raw == "" {
+		return "none (hex)"
+	}
+	return fmt.Sprintf("%s (hex)", c.raw)
+}
@@ -0,0 +1,57 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionHexConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "2.3.1", constraint: "", satisfied: true},
+		// typical GHSA ranges
+		{version: "1.6.2", constraint: ">= 1.6.0, < 1.6.3", satisfied: true},
+		{version: "1.6.3", constraint: ">= 1.6.0, < 1.6.3", satisfied: false},
+		{version: "1.5.9", constraint: "< 1.5.10 || >= 1.6.0, < 1.6.3", satisfied: true},
+		// elixir requirements
+		{version: "2.0.0", constraint: "== 2.0.0", satisfied: true},
+		{version: "2.0.1", constraint: "== 2.0.0", satisfied: false},
+		{version: "2.0.1", constraint: ">= 2.0.0 and < 2.1.0", satisfied: true},
+		{version: "2.1.0", constraint: ">= 2.0.0 and < 2.1.0 or >= 3.0.0", satisfied: false},
+		{version: "3.0.1", constraint: ">= 2.0.0 and < 2.1.0 or >= 3.0.0", satisfied: true},
+		{version: "2.0.1", constraint: "!= 2.0.1", satisfied: false},
+		{version: "2.0.2", constraint: ">= 2.0.0 and != 2.0.1", satisfied: true},
+		{version: "1.9.0", constraint: ">= 2.0.0 and != 2.0.1", satisfied: false},
+		// "~>" with major.minor allows any later minor version
+		{version: "2.9.0", constraint: "~> 2.1", satisfied: true},
+		{version: "2.0.9", constraint: "~> 2.1", satisfied: false},
+		{version: "3.0.0", constraint: "~> 2.1", satisfied: false},
+		{version: "3.0.0-rc.0", constraint: "~> 2.1", satisfied: false},
+		// "~>" with major.minor.patch allows any later patch version
+		{version: "2.1.9", constraint: "~> 2.1.2", satisfied: true},
+		{version: "2.1.1", constraint: "~> 2.1.2", satisfied: false},
+		{version: "2.2.0", constraint: "~> 2.1.2", satisfied: false},
+		{version: "1.4.0", constraint: "~> 1.2 and != 1.3.0 or ~> 2.0.1", satisfied: true},
+		{version: "1.3.0", constraint: "~> 1.2 and != 1.3.0 or ~> 2.0.1", satisfied: false},
+		{version: "2.0.5", constraint: "~> 1.2 and != 1.3.0 or ~> 2.0.1", satisfied: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newHexConstraint(test.constraint)
+			assert.NoError(t, err, "unexpected error from newHexConstraint: %v", err)
+
+			test.assertVersionConstraint(t, HexFormat, constraint)
+		})
+	}
+}
+
+func TestNewHexConstraint_Invalid(t *testing.T) {
+	for _, raw := range []string{"~> 2", ">= 2.0.0 and", "=> 2.0.0"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newHexConstraint(raw)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*hexVersion)(nil)
+
+// derived from Elixir's Version module, see https://hexdocs.pm/elixir/Version.html (the patch part is optional here
+// since requirements such as "~> 2.1" allow it to be omitted)
+var hexVersionPattern = regexp.MustCompile(`^v?(\d+)\.(\d+)(?:\.(\d+))?(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// hexVersion is an Erlang/Elixir Hex package version, which follows semver 2.0 (build metadata is ignored when ordering
+// versions).
+type hexVersion struct {
+	raw        string
+	numbers    []int
+	preRelease []string
+	// hasPatch indicates that the version was given with the patch part, which affects the "~>" requirement operator
+	hasPatch bool
+}
+
+func newHexVersion(raw string) (*hexVersion, error) {
+	m := hexVersionPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if m == nil {
+		return nil, fmt.Errorf("unable to parse hex version: %q", raw)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range m[1:4] {
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex version %q: %w", raw, err)
+		}
+		numbers[i] = n
+	}
+
+	return &hexVersion{
+		raw:        raw,
+		numbers:    numbers,
+		preRelease: splitSemverIdentifiers(m[4]),
+		hasPatch:   m[3] != "",
+	}, nil
+}
+
+func (v *hexVersion) Compare(other *Version) (int, error) {
+	if other.Format != HexFormat {
+		return -1, fmt.Errorf("unable to compare hex to given format: %s", other.Format)
+	}
+	if other.rich.hexVer == nil {
+		return -1, fmt.Errorf("given empty hexVersion object")
+	}
+
+	return other.rich.hexVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v hexVersion) compare(v2 hexVersion) int {
+	if c := compareSemverNumbers(v.numbers, v2.numbers); c != 0 {
+		return c
+	}
+	return compareSemverPreRelease(v.preRelease, v2.preRelease)
+}
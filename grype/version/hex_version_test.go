@@ -0,0 +1,52 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionHex(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.1", "2.1.0", 0},
+		// pre-releases sort before the release
+		{"1.0.0-rc.0", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.11", 1},
+		// builds are ignored
+		{"1.0.0+build.1", "1.0.0", 0},
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newHexVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newHexVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewHexVersion_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "1", "1.0.0.0", "not a version"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newHexVersion(raw)
+			assert.Error(t, err)
+		})
+	}
+}
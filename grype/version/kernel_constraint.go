@@ -0,0 +1,69 @@
+package version
+
+import (
+	"fmt"
+)
+
+type kernelConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newKernelConstraint(raw string) (kernelConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return kernelConstraint{}, nil
+	}
+
+	constraints, err := newConstraintExpression(raw, newKernelComparator)
+	if err != nil {
+		return kernelConstraint{}, fmt.Errorf("unable to parse kernel constraint phrase: %w", err)
+	}
+
+	return kernelConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+func newKernelComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newKernelVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c kernelConstraint) supported(format Format) bool {
+	return format == KernelFormat
+}
+
+func (c kernelConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(kernel) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.kernelVer == nil {
+		return false, fmt.Errorf("no rich kernel version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c kernelConstraint) String() string {
+	if c.raw == "" {
+		return "none (kernel)"
+	}
+	return fmt.Sprintf("%s (kernel)", c.raw)
+}
@@ -0,0 +1,40 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionKernelConstraint(t *testing.T) {
+	// affected ranges as published by the kernel.org CNA, where each stable branch has its own fix
+	stableRanges := ">= 5.10, < 5.10.209 || >= 5.15, < 5.15.148 || >= 6.1, < 6.1.75 || >= 6.2, < 6.8-rc1"
+
+	tests := []testCase{
+		// empty values
+		{version: "6.1.0", constraint: "", satisfied: true},
+		// stable branches
+		{version: "5.4.268", constraint: stableRanges, satisfied: false},
+		{version: "5.10.208", constraint: stableRanges, satisfied: true},
+		{version: "5.10.209", constraint: stableRanges, satisfied: false},
+		{version: "5.15.147-custom", constraint: stableRanges, satisfied: true},
+		{version: "5.15.148", constraint: stableRanges, satisfied: false},
+		// branches without a backported fix are affected until the fix in mainline
+		{version: "5.16.20", constraint: ">= 5.10, < 5.10.209 || >= 5.11, < 6.8", satisfied: true},
+		{version: "6.7.12", constraint: stableRanges, satisfied: true},
+		// release candidates
+		{version: "6.8-rc1", constraint: stableRanges, satisfied: false},
+		{version: "6.7-rc8", constraint: stableRanges, satisfied: true},
+		{version: "6.8-rc3", constraint: "< 6.8", satisfied: true},
+		{version: "6.8", constraint: "< 6.8", satisfied: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newKernelConstraint(test.constraint)
+			assert.NoError(t, err, "unexpected error from newKernelConstraint: %v", err)
+
+			test.assertVersionConstraint(t, KernelFormat, constraint)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*kernelVersion)(nil)
+
+// kernel versions are "<version>.<patchlevel>[.<sublevel>][-rc<N>]", optionally followed by a local version (e.g.
+// "-generic", "+" or "-200.fc37.x86_64") which is ignored. Kernels from the 2.6 era have an extra stable part
+// (e.g. "2.6.32.71").
+var kernelVersionPattern = regexp.MustCompile(`^[vV]?(\d+)\.(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-rc(\d+))?(.*)$`)
+
+// kernelVersion is a Linux kernel version, where release candidates (e.g. "6.8-rc3") sort before the release
+// ("6.8" == "6.8.0") and stable releases ("6.8.1") sort after it.
+type kernelVersion struct {
+	raw     string
+	numbers []int
+	// rc is the release candidate number, 0 for releases
+	rc int
+}
+
+func newKernelVersion(raw string) (*kernelVersion, error) {
+	m := kernelVersionPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if m == nil {
+		return nil, fmt.Errorf("unable to parse kernel version: %q", raw)
+	}
+
+	numbers := make([]int, 4)
+	for i, part := range m[1:5] {
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid kernel version %q: %w", raw, err)
+		}
+		numbers[i] = n
+	}
+
+	var rc int
+	if m[5] != "" {
+		n, err := strconv.Atoi(m[5])
+		if err != nil {
+			return nil, fmt.Errorf("invalid kernel release candidate %q: %w", raw, err)
+		}
+		// "-rc0" does not exist, keep it distinct from the release
+		rc = n + 1
+	}
+
+	return &kernelVersion{
+		raw:     raw,
+		numbers: numbers,
+		rc:      rc,
+	}, nil
+}
+
+func (v *kernelVersion) Compare(other *Version) (int, error) {
+	if other.Format != KernelFormat {
+		return -1, fmt.Errorf("unable to compare kernel to given format: %s", other.Format)
+	}
+	if other.rich.kernelVer == nil {
+		return -1, fmt.Errorf("given empty kernelVersion object")
+	}
+
+	return other.rich.kernelVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v kernelVersion) compare(v2 kernelVersion) int {
+	if c := compareSemverNumbers(v.numbers, v2.numbers); c != 0 {
+		return c
+	}
+
+	switch {
+	case v.rc == v2.rc:
+		return 0
+	case v.rc == 0:
+		// a release sorts after its release candidates
+		return 1
+	case v2.rc == 0:
+		return -1
+	case v.rc < v2.rc:
+		return -1
+	default:
+		return 1
+	}
+}
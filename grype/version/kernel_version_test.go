@@ -0,0 +1,57 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionKernel(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"6.1.75", "6.1.75", 0},
+		{"6.1", "6.1.0", 0},
+		{"5.10.209", "5.10.21", 1},
+		{"5.15.148", "6.1.1", -1},
+		// release candidates sort before the release
+		{"6.8-rc1", "6.8", -1},
+		{"6.8-rc7", "6.8-rc10", -1},
+		{"6.8-rc7", "6.7.12", 1},
+		{"6.8.0-rc3", "6.8-rc3", 0},
+		// local versions are ignored
+		{"5.15.0-91-generic", "5.15.0", 0},
+		{"6.2.9-200.fc37.x86_64", "6.2.9", 0},
+		{"6.6.12+", "6.6.12", 0},
+		{"6.7.0-rc4-custom", "6.7-rc4", 0},
+		// 2.6 era stable releases
+		{"2.6.32.71", "2.6.32.8", 1},
+		{"2.6.32", "2.6.32.1", -1},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newKernelVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newKernelVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewKernelVersion_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "6", "linux", "-rc1"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newKernelVersion(raw)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package version
+
+import (
+	"fmt"
+)
+
+type nixConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newNixConstraint(raw string) (nixConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return nixConstraint{}, nil
+	}
+
+	constraints, err := newConstraintExpression(raw, newNixComparator)
+	if err != nil {
+		return nixConstraint{}, fmt.Errorf("unable to parse nix constraint phrase: %w", err)
+	}
+
+	return nixConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+func newNixComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newNixVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c nixConstraint) supported(format Format) bool {
+	return format == NixFormat
+}
+
+func (c nixConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(nix) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.nixVer == nil {
+		return false, fmt.Errorf("no rich nix version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c nixConstraint) String() string {
+	if c.raw == "" {
+		return "none (nix)"
+	}
+	return fmt.Sprintf("%s (nix)", c.raw)
+}
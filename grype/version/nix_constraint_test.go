@@ -0,0 +1,32 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionNixConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "3.0.13", constraint: "", satisfied: true},
+		// simple ranges
+		{version: "3.0.13", constraint: "< 3.0.14", satisfied: true},
+		{version: "3.0.14", constraint: "< 3.0.14", satisfied: false},
+		{version: "3.0.9", constraint: ">= 3.0.0, < 3.0.14", satisfied: true},
+		{version: "3.1.0", constraint: ">= 3.0.0, < 3.0.14 || >= 3.1.0, < 3.1.6", satisfied: true},
+		// nix ordering
+		{version: "2.3a", constraint: "< 2.3.1", satisfied: true},
+		{version: "1.0pre5", constraint: "< 1.0", satisfied: true},
+		{version: "2.39-52", constraint: "< 2.39", satisfied: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newNixConstraint(test.constraint)
+			assert.NoError(t, err, "unexpected error from newNixConstraint: %v", err)
+
+			test.assertVersionConstraint(t, NixFormat, constraint)
+		})
+	}
+}
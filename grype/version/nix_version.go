@@ -0,0 +1,116 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*nixVersion)(nil)
+
+// nixVersion is a nixpkgs package version, compared the same way as builtins.compareVersions (see
+// https://github.com/NixOS/nix/blob/master/src/libstore/names.cc)
+type nixVersion struct {
+	raw        string
+	components []string
+}
+
+func newNixVersion(raw string) (*nixVersion, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return nil, fmt.Errorf("unable to parse nix version: %q", raw)
+	}
+
+	return &nixVersion{
+		raw:        raw,
+		components: nixVersionComponents(trimmed),
+	}, nil
+}
+
+// nixVersionComponents splits the version into components, which are either the longest sequence of digits or the
+// longest sequence of non-digit characters, where dots and dashes separate components (e.g. "2.3a-pre1" is
+// ["2", "3", "a", "pre", "1"])
+func nixVersionComponents(v string) []string {
+	var components []string
+	for i := 0; i < len(v); {
+		if v[i] == '.' || v[i] == '-' {
+			i++
+			continue
+		}
+
+		start := i
+		if isNixDigit(v[i]) {
+			for i < len(v) && isNixDigit(v[i]) {
+				i++
+			}
+		} else {
+			for i < len(v) && !isNixDigit(v[i]) && v[i] != '.' && v[i] != '-' {
+				i++
+			}
+		}
+		components = append(components, v[start:i])
+	}
+	return components
+}
+
+func isNixDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (v *nixVersion) Compare(other *Version) (int, error) {
+	if other.Format != NixFormat {
+		return -1, fmt.Errorf("unable to compare nix to given format: %s", other.Format)
+	}
+	if other.rich.nixVer == nil {
+		return -1, fmt.Errorf("given empty nixVersion object")
+	}
+
+	return other.rich.nixVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v nixVersion) compare(v2 nixVersion) int {
+	for i := 0; i < len(v.components) || i < len(v2.components); i++ {
+		// missing components are empty, which sort before numbers (e.g. "1.0" < "1.0.0")
+		var c1, c2 string
+		if i < len(v.components) {
+			c1 = v.components[i]
+		}
+		if i < len(v2.components) {
+			c2 = v2.components[i]
+		}
+
+		if nixComponentLess(c1, c2) {
+			return -1
+		}
+		if nixComponentLess(c2, c1) {
+			return 1
+		}
+	}
+	return 0
+}
+
+// nixComponentLess orders version components: numbers are compared numerically, "pre" sorts before everything else,
+// and strings sort before numbers (e.g. "2.3a" < "2.3.1").
+func nixComponentLess(c1, c2 string) bool {
+	n1, err1 := strconv.ParseUint(c1, 10, 64)
+	n2, err2 := strconv.ParseUint(c2, 10, 64)
+	isNum1, isNum2 := err1 == nil, err2 == nil
+
+	switch {
+	case isNum1 && isNum2:
+		return n1 < n2
+	case c1 == "" && isNum2:
+		return true
+	case c1 == "pre" && c2 != "pre":
+		return true
+	case c2 == "pre":
+		return false
+	case isNum2:
+		return true
+	case isNum1:
+		return false
+	default:
+		return c1 < c2
+	}
+}
@@ -0,0 +1,60 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionNix(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"1.0", "1.0", 0},
+		{"2.3", "2.3.1", -1},
+		{"1.0", "1.0.0", -1},
+		{"2.10", "2.9", 1},
+		{"3.0.13", "3.0.9", 1},
+		// strings sort before numbers
+		{"2.3a", "2.3.1", -1},
+		{"2.3a", "2.3b", -1},
+		{"2.3a", "2.3", 1},
+		// "pre" sorts before everything
+		{"2.3pre1", "2.3", -1},
+		{"2.3pre1", "2.3pre2", -1},
+		{"2.3pre3", "2.3pre12", -1},
+		{"2.3pre1", "2.3c", -1},
+		{"2.3pre1", "2.3q", -1},
+		// dots and dashes are both separators
+		{"2.34-210", "2.34.210", 0},
+		{"1.2.3-rc1", "1.2.3", 1},
+		// snapshots
+		{"unstable-2023-01-01", "unstable-2023-10-01", -1},
+		{"0-unstable-2024-01-01", "1.0", -1},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newNixVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newNixVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNixVersionComponents(t *testing.T) {
+	assert.Equal(t, []string{"2", "3", "a", "pre", "1"}, nixVersionComponents("2.3a-pre1"))
+	assert.Equal(t, []string{"1", "2", "3"}, nixVersionComponents("1..2--3"))
+
+	_, err := newNixVersion(" ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,424 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// the patterns below are derived from the (non-loose) range patterns in node-semver, see
+// https://github.com/npm/node-semver/blob/main/internal/re.js
+const (
+	npmXRangeIdentifier = `(` + npmNumericIdentifier + `|x|X|\*)`
+	npmXRangePlain      = `[v=\s]*` + npmXRangeIdentifier + `(?:\.` + npmXRangeIdentifier + `(?:\.` + npmXRangeIdentifier + npmPreRelease + `?` + npmBuild + `?)?)?`
+	npmGTLT             = `((?:<|>)?=?)`
+)
+
+var (
+	npmOrPattern         = regexp.MustCompile(`\s*\|\|\s*`)
+	npmHyphenPattern     = regexp.MustCompile(`^\s*(` + npmXRangePlain + `)\s+-\s+(` + npmXRangePlain + `)\s*$`)
+	npmOperatorTrim      = regexp.MustCompile(`((?:<|>)=?|=)\s+`)
+	npmTildeTrim         = regexp.MustCompile(`~>?\s+`)
+	npmCaretTrim         = regexp.MustCompile(`\^\s+`)
+	npmTildePattern      = regexp.MustCompile(`^~>?` + npmXRangePlain + `$`)
+	npmCaretPattern      = regexp.MustCompile(`^\^` + npmXRangePlain + `$`)
+	npmXRangePattern     = regexp.MustCompile(`^` + npmGTLT + `\s*` + npmXRangePlain + `$`)
+	npmComparatorPattern = regexp.MustCompile(`^` + npmGTLT + `\s*(` + npmFullPlain + `)$`)
+	npmGTE0Pattern       = regexp.MustCompile(`^\s*>=\s*0\.0\.0\s*$`)
+)
+
+// npmComparator is a single desugared comparator (e.g. ">=1.2.3"), where a nil version matches any version
+type npmComparator struct {
+	op      operator
+	version *npmVersion
+}
+
+type npmConstraint struct {
+	raw string
+	// sets are or'd together, the comparators within a set are and'ed
+	sets [][]npmComparator
+}
+
+func newNpmConstraint(raw string) (npmConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return npmConstraint{}, nil
+	}
+
+	var sets [][]npmComparator
+	for _, part := range npmOrPattern.Split(strings.TrimSpace(raw), -1) {
+		set, err := parseNpmComparatorSet(part)
+		if err != nil {
+			return npmConstraint{}, fmt.Errorf("unable to parse npm constraint phrase %q: %w", raw, err)
+		}
+		sets = append(sets, set)
+	}
+
+	return npmConstraint{
+		raw:  raw,
+		sets: sets,
+	}, nil
+}
+
+// parseNpmComparatorSet desugars hyphen ranges, tilde ranges, caret ranges and x-ranges of a single (and'ed) comparator
+// set the same way node-semver does. Commas are treated as whitespace, since advisory ranges are commonly expressed as
+// ">= 1.0.0, < 1.2.3".
+func parseNpmComparatorSet(phrase string) ([]npmComparator, error) {
+	phrase = strings.TrimSpace(strings.ReplaceAll(phrase, ",", " "))
+
+	phrase, err := npmReplaceHyphen(phrase)
+	if err != nil {
+		return nil, err
+	}
+	phrase = npmOperatorTrim.ReplaceAllString(phrase, "$1")
+	phrase = npmTildeTrim.ReplaceAllString(phrase, "~")
+	phrase = npmCaretTrim.ReplaceAllString(phrase, "^")
+
+	var desugared []string
+	for _, comp := range strings.Fields(phrase) {
+		for _, replace := range []func(string) (string, error){npmReplaceCaret, npmReplaceTilde, npmReplaceXRange} {
+			if comp, err = replace(comp); err != nil {
+				return nil, err
+			}
+		}
+		desugared = append(desugared, strings.Fields(comp)...)
+	}
+
+	set := make([]npmComparator, 0, len(desugared))
+	for _, comp := range desugared {
+		c, err := newNpmComparator(comp)
+		if err != nil {
+			return nil, err
+		}
+		set = append(set, c)
+	}
+	if len(set) == 0 {
+		// an empty set (e.g. "" or "*") matches any version
+		set = append(set, npmComparator{})
+	}
+
+	return set, nil
+}
+
+func newNpmComparator(comp string) (npmComparator, error) {
+	if npmGTE0Pattern.MatchString(comp) {
+		return npmComparator{}, nil
+	}
+
+	m := npmComparatorPattern.FindStringSubmatch(comp)
+	if m == nil {
+		return npmComparator{}, fmt.Errorf("invalid npm comparator: %q", comp)
+	}
+
+	op, err := parseOperator(m[1])
+	if err != nil {
+		return npmComparator{}, err
+	}
+
+	ver, err := newNpmVersion(m[2])
+	if err != nil {
+		return npmComparator{}, err
+	}
+
+	return npmComparator{op: op, version: ver}, nil
+}
+
+func isNpmX(id string) bool {
+	return id == "" || id == "x" || id == "X" || id == "*"
+}
+
+func npmIncrement(id string) (string, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return "", fmt.Errorf("invalid npm version number %q: %w", id, err)
+	}
+	return strconv.Itoa(n + 1), nil
+}
+
+// npmReplaceHyphen desugars "1.2 - 2.3.4" into ">=1.2.0 <=2.3.4"
+func npmReplaceHyphen(phrase string) (string, error) {
+	m := npmHyphenPattern.FindStringSubmatch(phrase)
+	if m == nil {
+		return phrase, nil
+	}
+	from, fM, fm, fp := m[1], m[2], m[3], m[4]
+	to, tM, tm, tp, tpr := m[7], m[8], m[9], m[10], m[11]
+
+	switch {
+	case isNpmX(fM):
+		from = ""
+	case isNpmX(fm):
+		from = fmt.Sprintf(">=%s.0.0", fM)
+	case isNpmX(fp):
+		from = fmt.Sprintf(">=%s.%s.0", fM, fm)
+	default:
+		from = ">=" + from
+	}
+
+	switch {
+	case isNpmX(tM):
+		to = ""
+	case isNpmX(tm):
+		next, err := npmIncrement(tM)
+		if err != nil {
+			return "", err
+		}
+		to = fmt.Sprintf("<%s.0.0-0", next)
+	case isNpmX(tp):
+		next, err := npmIncrement(tm)
+		if err != nil {
+			return "", err
+		}
+		to = fmt.Sprintf("<%s.%s.0-0", tM, next)
+	case tpr != "":
+		to = fmt.Sprintf("<=%s.%s.%s-%s", tM, tm, tp, tpr)
+	default:
+		to = "<=" + to
+	}
+
+	return strings.TrimSpace(from + " " + to), nil
+}
+
+// npmReplaceCaret desugars caret ranges, which allow changes that do not modify the left-most non-zero part:
+// "^1.2.3" is ">=1.2.3 <2.0.0-0", "^0.2.3" is ">=0.2.3 <0.3.0-0" and "^0.0.3" is ">=0.0.3 <0.0.4-0"
+func npmReplaceCaret(comp string) (string, error) {
+	m := npmCaretPattern.FindStringSubmatch(comp)
+	if m == nil {
+		return comp, nil
+	}
+	M, mi, p, pr := m[1], m[2], m[3], m[4]
+	if pr != "" {
+		pr = "-" + pr
+	}
+
+	if isNpmX(M) {
+		return "", nil
+	}
+	nextM, err := npmIncrement(M)
+	if err != nil {
+		return "", err
+	}
+	if isNpmX(mi) {
+		return fmt.Sprintf(">=%s.0.0 <%s.0.0-0", M, nextM), nil
+	}
+	nextMi, err := npmIncrement(mi)
+	if err != nil {
+		return "", err
+	}
+	if isNpmX(p) {
+		if M == "0" {
+			return fmt.Sprintf(">=%s.%s.0 <%s.%s.0-0", M, mi, M, nextMi), nil
+		}
+		return fmt.Sprintf(">=%s.%s.0 <%s.0.0-0", M, mi, nextM), nil
+	}
+	nextP, err := npmIncrement(p)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case M == "0" && mi == "0":
+		return fmt.Sprintf(">=%s.%s.%s%s <%s.%s.%s-0", M, mi, p, pr, M, mi, nextP), nil
+	case M == "0":
+		return fmt.Sprintf(">=%s.%s.%s%s <%s.%s.0-0", M, mi, p, pr, M, nextMi), nil
+	default:
+		return fmt.Sprintf(">=%s.%s.%s%s <%s.0.0-0", M, mi, p, pr, nextM), nil
+	}
+}
+
+// npmReplaceTilde desugars tilde ranges, which allow patch-level changes when a minor version is given and minor-level
+// changes otherwise: "~1.2.3" is ">=1.2.3 <1.3.0-0" and "~1" is ">=1.0.0 <2.0.0-0"
+func npmReplaceTilde(comp string) (string, error) {
+	m := npmTildePattern.FindStringSubmatch(comp)
+	if m == nil {
+		return comp, nil
+	}
+	M, mi, p, pr := m[1], m[2], m[3], m[4]
+	if pr != "" {
+		pr = "-" + pr
+	}
+
+	if isNpmX(M) {
+		return "", nil
+	}
+	if isNpmX(mi) {
+		nextM, err := npmIncrement(M)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(">=%s.0.0 <%s.0.0-0", M, nextM), nil
+	}
+	nextMi, err := npmIncrement(mi)
+	if err != nil {
+		return "", err
+	}
+	if isNpmX(p) {
+		return fmt.Sprintf(">=%s.%s.0 <%s.%s.0-0", M, mi, M, nextMi), nil
+	}
+	return fmt.Sprintf(">=%s.%s.%s%s <%s.%s.0-0", M, mi, p, pr, M, nextMi), nil
+}
+
+// npmReplaceXRange desugars x-ranges (where "x", "X", "*" or a missing part is a wildcard), optionally combined with an
+// operator: "1.2.x" is ">=1.2.0 <1.3.0-0", ">1.2" is ">=1.3.0" and "<=1" is "<2.0.0-0"
+func npmReplaceXRange(comp string) (string, error) {
+	m := npmXRangePattern.FindStringSubmatch(comp)
+	if m == nil {
+		return comp, nil
+	}
+	gtlt, M, mi, p := m[1], m[2], m[3], m[4]
+
+	xM := isNpmX(M)
+	xm := xM || isNpmX(mi)
+	xp := xm || isNpmX(p)
+	if !xp {
+		// not an x-range
+		return comp, nil
+	}
+	if gtlt == "=" {
+		gtlt = ""
+	}
+
+	if xM {
+		if gtlt == ">" || gtlt == "<" {
+			// nothing is allowed
+			return "<0.0.0-0", nil
+		}
+		// anything is allowed
+		return "", nil
+	}
+
+	var err error
+	if gtlt != "" {
+		if xm {
+			mi = "0"
+		}
+		p = "0"
+
+		var pr string
+		switch gtlt {
+		case ">":
+			// ">1" is ">=2.0.0" and ">1.2" is ">=1.3.0"
+			gtlt = ">="
+			if xm {
+				M, err = npmIncrement(M)
+				mi = "0"
+			} else {
+				mi, err = npmIncrement(mi)
+			}
+		case "<=":
+			// "<=1" is "<2.0.0-0" and "<=1.2" is "<1.3.0-0"
+			gtlt = "<"
+			if xm {
+				M, err = npmIncrement(M)
+			} else {
+				mi, err = npmIncrement(mi)
+			}
+		}
+		if err != nil {
+			return "", err
+		}
+		if gtlt == "<" {
+			pr = "-0"
+		}
+		return fmt.Sprintf("%s%s.%s.%s%s", gtlt, M, mi, p, pr), nil
+	}
+
+	if xm {
+		nextM, err := npmIncrement(M)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(">=%s.0.0 <%s.0.0-0", M, nextM), nil
+	}
+
+	nextMi, err := npmIncrement(mi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(">=%s.%s.0 <%s.%s.0-0", M, mi, M, nextMi), nil
+}
+
+func (c npmComparator) satisfied(v npmVersion) bool {
+	if c.version == nil {
+		return true
+	}
+
+	comparison := v.compare(*c.version)
+	switch c.op {
+	case GT:
+		return comparison > 0
+	case GTE:
+		return comparison >= 0
+	case LT:
+		return comparison < 0
+	case LTE:
+		return comparison <= 0
+	default:
+		return comparison == 0
+	}
+}
+
+// satisfiedBySet follows node-semver's prerelease rules: a prerelease version only satisfies a set when one of the
+// comparators has a prerelease on the same [major, minor, patch] tuple (so "1.2.4-beta" does not satisfy ">1.2.3", but
+// "1.2.3-beta.2" does satisfy ">1.2.3-beta.1").
+func satisfiedBySet(set []npmComparator, v npmVersion) bool {
+	for _, c := range set {
+		if !c.satisfied(v) {
+			return false
+		}
+	}
+
+	if len(v.preRelease) == 0 {
+		return true
+	}
+
+	for _, c := range set {
+		if c.version == nil || len(c.version.preRelease) == 0 {
+			continue
+		}
+		if compareSemverNumbers(c.version.numbers, v.numbers) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c npmConstraint) supported(format Format) bool {
+	return format == NpmFormat
+}
+
+func (c npmConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(npm) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.npmVer == nil {
+		return false, fmt.Errorf("no rich npm version given: %+v", version)
+	}
+
+	for _, set := range c.sets {
+		if satisfiedBySet(set, *version.rich.npmVer) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c npmConstraint) String() string {
+	if c.raw == "" {
+		return "none (npm)"
+	}
+	return fmt.Sprintf("%s (npm)", c.raw)
+}
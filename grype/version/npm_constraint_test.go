@@ -0,0 +1,266 @@
+package version
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cases derived from node-semver's range-include and range-exclude fixtures (excluding the loose and includePrerelease
+// options, which npm does not use when installing packages), see https://github.com/npm/node-semver/tree/main/test/fixtures
+func TestVersionNpmConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "1.0.0-beta", constraint: "", satisfied: true},
+		// range-include
+		{version: "1.2.3", constraint: "1.0.0 - 2.0.0", satisfied: true},
+		{version: "1.2.3", constraint: "^1.2.3+build", satisfied: true},
+		{version: "1.3.0", constraint: "^1.2.3+build", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.3-pre+asdf - 2.4.3-pre+asdf", satisfied: true},
+		{version: "1.2.3-pre.2", constraint: "1.2.3-pre+asdf - 2.4.3-pre+asdf", satisfied: true},
+		{version: "2.4.3-alpha", constraint: "1.2.3-pre+asdf - 2.4.3-pre+asdf", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.3+asdf - 2.4.3+asdf", satisfied: true},
+		{version: "1.0.0", constraint: "1.0.0", satisfied: true},
+		{version: "0.2.4", constraint: ">=*", satisfied: true},
+		{version: "1.2.3", constraint: "*", satisfied: true},
+		{version: "1.0.0", constraint: ">=1.0.0", satisfied: true},
+		{version: "1.0.1", constraint: ">=1.0.0", satisfied: true},
+		{version: "1.1.0", constraint: ">=1.0.0", satisfied: true},
+		{version: "1.0.1", constraint: ">1.0.0", satisfied: true},
+		{version: "1.1.0", constraint: ">1.0.0", satisfied: true},
+		{version: "2.0.0", constraint: "<=2.0.0", satisfied: true},
+		{version: "1.9999.9999", constraint: "<=2.0.0", satisfied: true},
+		{version: "0.2.9", constraint: "<=2.0.0", satisfied: true},
+		{version: "1.9999.9999", constraint: "<2.0.0", satisfied: true},
+		{version: "0.2.9", constraint: "<2.0.0", satisfied: true},
+		{version: "1.0.0", constraint: ">= 1.0.0", satisfied: true},
+		{version: "1.0.1", constraint: ">=  1.0.0", satisfied: true},
+		{version: "1.1.0", constraint: ">=   1.0.0", satisfied: true},
+		{version: "1.0.1", constraint: "> 1.0.0", satisfied: true},
+		{version: "1.1.0", constraint: ">  1.0.0", satisfied: true},
+		{version: "2.0.0", constraint: "<=   2.0.0", satisfied: true},
+		{version: "1.9999.9999", constraint: "<= 2.0.0", satisfied: true},
+		{version: "0.2.9", constraint: "<=  2.0.0", satisfied: true},
+		{version: "1.9999.9999", constraint: "<    2.0.0", satisfied: true},
+		{version: "0.2.9", constraint: "<\t2.0.0", satisfied: true},
+		{version: "0.1.97", constraint: ">=0.1.97", satisfied: true},
+		{version: "1.2.4", constraint: "0.1.20 || 1.2.4", satisfied: true},
+		{version: "0.0.0", constraint: ">=0.2.3 || <0.0.1", satisfied: true},
+		{version: "0.2.3", constraint: ">=0.2.3 || <0.0.1", satisfied: true},
+		{version: "0.2.4", constraint: ">=0.2.3 || <0.0.1", satisfied: true},
+		{version: "1.3.4", constraint: "||", satisfied: true},
+		{version: "2.1.3", constraint: "2.x.x", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.x", satisfied: true},
+		{version: "2.1.3", constraint: "1.2.x || 2.x", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.x || 2.x", satisfied: true},
+		{version: "1.2.3", constraint: "x", satisfied: true},
+		{version: "2.1.3", constraint: "2.*.*", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.*", satisfied: true},
+		{version: "2.1.3", constraint: "1.2.* || 2.*", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.* || 2.*", satisfied: true},
+		{version: "2.1.2", constraint: "2", satisfied: true},
+		{version: "2.3.1", constraint: "2.3", satisfied: true},
+		{version: "0.0.1", constraint: "~0.0.1", satisfied: true},
+		{version: "0.0.2", constraint: "~0.0.1", satisfied: true},
+		{version: "0.0.9", constraint: "~x", satisfied: true},
+		{version: "2.0.9", constraint: "~2", satisfied: true},
+		{version: "2.4.0", constraint: "~2.4", satisfied: true},
+		{version: "2.4.5", constraint: "~2.4", satisfied: true},
+		{version: "3.2.2", constraint: "~>3.2.1", satisfied: true},
+		{version: "1.2.3", constraint: "~1", satisfied: true},
+		{version: "1.2.3", constraint: "~>1", satisfied: true},
+		{version: "1.2.3", constraint: "~> 1", satisfied: true},
+		{version: "1.0.2", constraint: "~1.0", satisfied: true},
+		{version: "1.0.2", constraint: "~ 1.0", satisfied: true},
+		{version: "1.0.12", constraint: "~ 1.0.3", satisfied: true},
+		{version: "1.0.0", constraint: ">=1", satisfied: true},
+		{version: "1.0.0", constraint: ">= 1", satisfied: true},
+		{version: "1.1.1", constraint: "<1.2", satisfied: true},
+		{version: "1.1.1", constraint: "< 1.2", satisfied: true},
+		{version: "0.5.5", constraint: "~v0.5.4-pre", satisfied: true},
+		{version: "0.5.4", constraint: "~v0.5.4-pre", satisfied: true},
+		{version: "0.7.2", constraint: "=0.7.x", satisfied: true},
+		{version: "0.7.2", constraint: "<=0.7.x", satisfied: true},
+		{version: "0.7.2", constraint: ">=0.7.x", satisfied: true},
+		{version: "0.6.2", constraint: "<=0.7.x", satisfied: true},
+		{version: "1.2.3", constraint: "~1.2.1 >=1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: "~1.2.1 =1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: "~1.2.1 1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: "~1.2.1 >=1.2.3 1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: "~1.2.1 1.2.3 >=1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: ">=1.2.1 1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: "1.2.3 >=1.2.1", satisfied: true},
+		{version: "1.2.3", constraint: ">=1.2.3 >=1.2.1", satisfied: true},
+		{version: "1.2.3", constraint: ">=1.2.1 >=1.2.3", satisfied: true},
+		{version: "1.2.8", constraint: ">=1.2", satisfied: true},
+		{version: "1.8.1", constraint: "^1.2.3", satisfied: true},
+		{version: "0.1.2", constraint: "^0.1.2", satisfied: true},
+		{version: "0.1.2", constraint: "^0.1", satisfied: true},
+		{version: "0.0.1", constraint: "^0.0.1", satisfied: true},
+		{version: "1.4.2", constraint: "^1.2", satisfied: true},
+		{version: "1.4.2", constraint: "^1.2 ^1", satisfied: true},
+		{version: "1.2.3-pre", constraint: "^1.2.3-alpha", satisfied: true},
+		{version: "1.2.0-pre", constraint: "^1.2.0-alpha", satisfied: true},
+		{version: "0.0.1-beta", constraint: "^0.0.1-alpha", satisfied: true},
+		{version: "0.0.1", constraint: "^0.0.1-alpha", satisfied: true},
+		{version: "0.1.1-beta", constraint: "^0.1.1-alpha", satisfied: true},
+		{version: "1.2.3", constraint: "^x", satisfied: true},
+		{version: "0.9.7", constraint: "x - 1.0.0", satisfied: true},
+		{version: "0.9.7", constraint: "x - 1.x", satisfied: true},
+		{version: "1.9.7", constraint: "1.0.0 - x", satisfied: true},
+		{version: "1.9.7", constraint: "1.x - x", satisfied: true},
+		{version: "7.9.9", constraint: "<=7.x", satisfied: true},
+		// range-exclude
+		{version: "2.2.3", constraint: "1.0.0 - 2.0.0", satisfied: false},
+		{version: "1.2.3-pre.2", constraint: "1.2.3+asdf - 2.4.3+asdf", satisfied: false},
+		{version: "2.4.3-alpha", constraint: "1.2.3+asdf - 2.4.3+asdf", satisfied: false},
+		{version: "2.0.0", constraint: "^1.2.3+build", satisfied: false},
+		{version: "1.2.0", constraint: "^1.2.3+build", satisfied: false},
+		{version: "1.2.3-pre", constraint: "^1.2.3", satisfied: false},
+		{version: "1.2.0-pre", constraint: "^1.2", satisfied: false},
+		{version: "1.3.0-beta", constraint: ">1.2", satisfied: false},
+		{version: "1.2.3-beta", constraint: "<=1.2.3", satisfied: false},
+		{version: "1.2.3-beta", constraint: "^1.2.3", satisfied: false},
+		{version: "0.7.0-asdf", constraint: "=0.7.x", satisfied: false},
+		{version: "0.7.0-asdf", constraint: ">=0.7.x", satisfied: false},
+		{version: "0.7.0-asdf", constraint: "<=0.7.x", satisfied: false},
+		{version: "1.0.1", constraint: "1.0.0", satisfied: false},
+		{version: "0.0.0", constraint: ">=1.0.0", satisfied: false},
+		{version: "0.0.1", constraint: ">=1.0.0", satisfied: false},
+		{version: "0.1.0", constraint: ">=1.0.0", satisfied: false},
+		{version: "0.0.1", constraint: ">1.0.0", satisfied: false},
+		{version: "0.1.0", constraint: ">1.0.0", satisfied: false},
+		{version: "3.0.0", constraint: "<=2.0.0", satisfied: false},
+		{version: "2.9999.9999", constraint: "<=2.0.0", satisfied: false},
+		{version: "2.2.9", constraint: "<=2.0.0", satisfied: false},
+		{version: "2.9999.9999", constraint: "<2.0.0", satisfied: false},
+		{version: "2.2.9", constraint: "<2.0.0", satisfied: false},
+		{version: "0.1.93", constraint: ">=0.1.97", satisfied: false},
+		{version: "1.2.3", constraint: "0.1.20 || 1.2.4", satisfied: false},
+		{version: "0.0.3", constraint: ">=0.2.3 || <0.0.1", satisfied: false},
+		{version: "0.2.2", constraint: ">=0.2.3 || <0.0.1", satisfied: false},
+		{version: "1.1.3", constraint: "2.x.x", satisfied: false},
+		{version: "3.1.3", constraint: "2.x.x", satisfied: false},
+		{version: "1.3.3", constraint: "1.2.x", satisfied: false},
+		{version: "3.1.3", constraint: "1.2.x || 2.x", satisfied: false},
+		{version: "1.1.3", constraint: "1.2.x || 2.x", satisfied: false},
+		{version: "1.1.3", constraint: "2.*.*", satisfied: false},
+		{version: "3.1.3", constraint: "2.*.*", satisfied: false},
+		{version: "1.3.3", constraint: "1.2.*", satisfied: false},
+		{version: "3.1.3", constraint: "1.2.* || 2.*", satisfied: false},
+		{version: "1.1.3", constraint: "1.2.* || 2.*", satisfied: false},
+		{version: "1.1.2", constraint: "2", satisfied: false},
+		{version: "2.4.1", constraint: "2.3", satisfied: false},
+		{version: "0.1.0-alpha", constraint: "~0.0.1", satisfied: false},
+		{version: "0.1.0", constraint: "~0.0.1", satisfied: false},
+		{version: "2.5.0", constraint: "~2.4", satisfied: false},
+		{version: "2.3.9", constraint: "~2.4", satisfied: false},
+		{version: "3.3.2", constraint: "~>3.2.1", satisfied: false},
+		{version: "3.2.0", constraint: "~>3.2.1", satisfied: false},
+		{version: "0.2.3", constraint: "~1", satisfied: false},
+		{version: "2.2.3", constraint: "~>1", satisfied: false},
+		{version: "1.1.0", constraint: "~1.0", satisfied: false},
+		{version: "1.0.0", constraint: "<1", satisfied: false},
+		{version: "1.1.1", constraint: ">=1.2", satisfied: false},
+		{version: "0.5.4-alpha", constraint: "~v0.5.4-beta", satisfied: false},
+		{version: "0.8.2", constraint: "=0.7.x", satisfied: false},
+		{version: "0.6.2", constraint: ">=0.7.x", satisfied: false},
+		{version: "0.7.2", constraint: "<0.7.x", satisfied: false},
+		{version: "1.2.3-beta", constraint: "<1.2.3", satisfied: false},
+		{version: "1.2.3-beta", constraint: "=1.2.3", satisfied: false},
+		{version: "1.2.8", constraint: ">1.2", satisfied: false},
+		{version: "0.0.2-alpha", constraint: "^0.0.1", satisfied: false},
+		{version: "0.0.2", constraint: "^0.0.1", satisfied: false},
+		{version: "2.0.0-alpha", constraint: "^1.2.3", satisfied: false},
+		{version: "1.2.2", constraint: "^1.2.3", satisfied: false},
+		{version: "1.1.9", constraint: "^1.2", satisfied: false},
+		{version: "2.0.0-rc1", constraint: "^1.0.0", satisfied: false},
+		{version: "2.0.0-pre", constraint: "1 - 2", satisfied: false},
+		{version: "1.0.0-pre", constraint: "1 - 2", satisfied: false},
+		{version: "1.0.0-pre", constraint: "1.0 - 2", satisfied: false},
+		{version: "1.0.0-a", constraint: "1.1.x", satisfied: false},
+		{version: "1.1.0-a", constraint: "1.1.x", satisfied: false},
+		{version: "1.2.0-a", constraint: "1.1.x", satisfied: false},
+		{version: "1.0.0-a", constraint: "1.x", satisfied: false},
+		{version: "1.1.0-a", constraint: "1.x", satisfied: false},
+		{version: "1.2.0-a", constraint: "1.x", satisfied: false},
+		{version: "1.1.0", constraint: ">=1.0.0 <1.1.0", satisfied: false},
+		{version: "1.1.0-pre", constraint: ">=1.0.0 <1.1.0", satisfied: false},
+		{version: "1.1.0-pre", constraint: ">=1.0.0 <1.1.0-pre", satisfied: false},
+		{version: "2.0.0-pre", constraint: "^1.2.3", satisfied: false},
+		{version: "1.2.3", constraint: "<x <* || >* 2.x", satisfied: false},
+		{version: "1.0.0", constraint: ">X", satisfied: false},
+		{version: "1.0.0", constraint: "<X", satisfied: false},
+		// advisory ranges use commas between comparators
+		{version: "1.2.2", constraint: ">= 1.0.0, < 1.2.3", satisfied: true},
+		{version: "1.2.3", constraint: ">= 1.0.0, < 1.2.3", satisfied: false},
+		{version: "4.17.20", constraint: "< 4.17.21 || >= 5.0.0, < 5.0.1", satisfied: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newNpmConstraint(test.constraint)
+			require.NoError(t, err, "unexpected error from newNpmConstraint: %v", err)
+
+			test.assertVersionConstraint(t, NpmFormat, constraint)
+		})
+	}
+}
+
+// cases derived from node-semver's range-parse fixtures for invalid ranges
+func TestNewNpmConstraint_Invalid(t *testing.T) {
+	for _, raw := range []string{"blerg", ">01.02.03", "~1.2.3beta", "1.2.3 - ", ">=1.2.3 <foo"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newNpmConstraint(raw)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNpmConstraint_desugar(t *testing.T) {
+	tests := []struct {
+		constraint string
+		expected   string
+	}{
+		{"1.0.0 - 2.0.0", ">=1.0.0 <=2.0.0"},
+		{"1 - 2", ">=1.0.0 <3.0.0-0"},
+		{"1.0 - 2.0", ">=1.0.0 <2.1.0-0"},
+		{"1", ">=1.0.0 <2.0.0-0"},
+		{"2.x.x", ">=2.0.0 <3.0.0-0"},
+		{"1.2.x", ">=1.2.0 <1.3.0-0"},
+		{"~2.4", ">=2.4.0 <2.5.0-0"},
+		{"~>3.2.1", ">=3.2.1 <3.3.0-0"},
+		{"^0", "<1.0.0-0"},
+		{"^ 1", ">=1.0.0 <2.0.0-0"},
+		{"^0.1", ">=0.1.0 <0.2.0-0"},
+		{"^0.0.1-beta", ">=0.0.1-beta <0.0.2-0"},
+		{"^1.2.3-beta.4", ">=1.2.3-beta.4 <2.0.0-0"},
+		{"<1", "<1.0.0-0"},
+		{">1.2", ">=1.3.0"},
+		{"<=1.2", "<1.3.0-0"},
+		{">X", "<0.0.0-0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.constraint, func(t *testing.T) {
+			c, err := newNpmConstraint(test.constraint)
+			require.NoError(t, err)
+			require.Len(t, c.sets, 1)
+
+			var comps []string
+			for _, comp := range c.sets[0] {
+				if comp.version == nil {
+					continue
+				}
+				op := string(comp.op)
+				if comp.op == EQ {
+					op = ""
+				}
+				comps = append(comps, op+comp.version.raw)
+			}
+			assert.Equal(t, test.expected, strings.Join(comps, " "))
+		})
+	}
+}
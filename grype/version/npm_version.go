@@ -0,0 +1,70 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*npmVersion)(nil)
+
+const (
+	npmNumericIdentifier    = `0|[1-9]\d*`
+	npmPreReleaseIdentifier = `(?:` + npmNumericIdentifier + `|\d*[a-zA-Z-][a-zA-Z0-9-]*)`
+	npmPreRelease           = `(?:-(` + npmPreReleaseIdentifier + `(?:\.` + npmPreReleaseIdentifier + `)*))`
+	npmBuild                = `(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))`
+	npmFullPlain            = `v?(` + npmNumericIdentifier + `)\.(` + npmNumericIdentifier + `)\.(` + npmNumericIdentifier + `)` + npmPreRelease + `?` + npmBuild + `?`
+)
+
+// derived from the (non-loose) FULL pattern in node-semver, see https://github.com/npm/node-semver/blob/main/internal/re.js
+var npmVersionPattern = regexp.MustCompile(`^` + npmFullPlain + `$`)
+
+// npmVersion is an npm package version, which is a strict semver 2.0 version (build metadata is ignored when ordering
+// versions).
+type npmVersion struct {
+	raw        string
+	numbers    []int
+	preRelease []string
+}
+
+func newNpmVersion(raw string) (*npmVersion, error) {
+	m := npmVersionPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if m == nil {
+		return nil, fmt.Errorf("unable to parse npm version: %q", raw)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range m[1:4] {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid npm version %q: %w", raw, err)
+		}
+		numbers[i] = n
+	}
+
+	return &npmVersion{
+		raw:        raw,
+		numbers:    numbers,
+		preRelease: splitSemverIdentifiers(m[4]),
+	}, nil
+}
+
+func (v *npmVersion) Compare(other *Version) (int, error) {
+	if other.Format != NpmFormat {
+		return -1, fmt.Errorf("unable to compare npm to given format: %s", other.Format)
+	}
+	if other.rich.npmVer == nil {
+		return -1, fmt.Errorf("given empty npmVersion object")
+	}
+
+	return other.rich.npmVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v npmVersion) compare(v2 npmVersion) int {
+	if c := compareSemverNumbers(v.numbers, v2.numbers); c != 0 {
+		return c
+	}
+	return compareSemverPreRelease(v.preRelease, v2.preRelease)
+}
@@ -0,0 +1,67 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cases derived from node-semver's comparison and equality fixtures, see
+// https://github.com/npm/node-semver/tree/main/test/fixtures
+func TestVersionNpm(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"0.0.0", "0.0.0-foo", 1},
+		{"0.0.1", "0.0.0", 1},
+		{"1.0.0", "0.9.9", 1},
+		{"0.10.0", "0.9.0", 1},
+		{"0.99.0", "0.10.0", 1},
+		{"2.0.0", "1.2.3", 1},
+		{"v0.0.0", "0.0.0-foo", 1},
+		{"v1.2.3", "1.2.3-asdf", 1},
+		{"1.2.3", "1.2.3-4", 1},
+		{"1.2.3", "1.2.3-4-foo", 1},
+		{"1.2.3-5-foo", "1.2.3-5", 1},
+		{"1.2.3-5", "1.2.3-4", 1},
+		{"1.2.3-5-foo", "1.2.3-5-Foo", 1},
+		{"3.0.0", "2.7.2+asdf", 1},
+		{"1.2.3-a.10", "1.2.3-a.5", 1},
+		{"1.2.3-a.b", "1.2.3-a.5", 1},
+		{"1.2.3-a.b", "1.2.3-a", 1},
+		{"1.2.3-a.b.c.10.d.5", "1.2.3-a.b.c.5.d.100", 1},
+		{"1.2.3-r2", "1.2.3-r100", 1},
+		{"1.2.3-r100", "1.2.3-R2", 1},
+		// equality
+		{"1.2.3", "v1.2.3", 0},
+		{"1.2.3-beta+build", "1.2.3-beta+otherbuild", 0},
+		{"1.2.3+build", "1.2.3+otherbuild", 0},
+		{" v1.2.3+build", "1.2.3+otherbuild", 0},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newNpmVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newNpmVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewNpmVersion_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "1.2", "1.2.3.4", "01.2.3", "1.2.3-01", "=1.2.3", "1.2.3beta", "not a version"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newNpmVersion(raw)
+			assert.Error(t, err)
+		})
+	}
+}
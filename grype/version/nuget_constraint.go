@@ -0,0 +1,150 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	nugetOrPattern       = regexp.MustCompile(`\|\|`)
+	nugetIntervalPattern = regexp.MustCompile(`([\[(])([^\[\]()]*)([\])])`)
+)
+
+type nugetConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newNuGetConstraint(raw string) (nugetConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return nugetConstraint{}, nil
+	}
+
+	phrase, err := normalizeNuGetRange(raw)
+	if err != nil {
+		return nugetConstraint{}, fmt.Errorf("unable to parse nuget constraint phrase: %w", err)
+	}
+
+	constraints, err := newConstraintExpression(phrase, newNuGetComparator)
+	if err != nil {
+		return nugetConstraint{}, fmt.Errorf("unable to parse nuget constraint phrase: %w", err)
+	}
+
+	return nugetConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+// normalizeNuGetRange rewrites NuGet interval notation (see
+// https://learn.microsoft.com/en-us/nuget/concepts/package-versioning#version-ranges) into a constraint expression:
+//   - "[1.0,2.0)" is ">= 1.0, < 2.0" and "(1.0,2.0]" is "> 1.0, <= 2.0"
+//   - "(,1.0]" and "[1.0,)" omit the lower and upper bound respectively
+//   - "[1.0]" is "= 1.0"
+//
+// Several intervals (e.g. "[1.0,2.0), [3.0,4.0)") are or'd together. Phrases that are not in interval notation (e.g.
+// ">= 1.0, < 2.0") are left as they are.
+func normalizeNuGetRange(raw string) (string, error) {
+	var orGroups []string
+	for _, orPart := range nugetOrPattern.Split(raw, -1) {
+		if !strings.ContainsAny(orPart, "[]()") {
+			orGroups = append(orGroups, strings.TrimSpace(orPart))
+			continue
+		}
+
+		// anything between the intervals may only be separators
+		if rest := nugetIntervalPattern.ReplaceAllString(orPart, ""); strings.Trim(rest, ", ") != "" {
+			return "", fmt.Errorf("invalid nuget version range %q", orPart)
+		}
+
+		for _, m := range nugetIntervalPattern.FindAllStringSubmatch(orPart, -1) {
+			group, err := nugetIntervalUnits(m[1], m[2], m[3])
+			if err != nil {
+				return "", err
+			}
+			orGroups = append(orGroups, group)
+		}
+	}
+
+	return strings.Join(orGroups, " || "), nil
+}
+
+func nugetIntervalUnits(open, bounds, closing string) (string, error) {
+	interval := open + bounds + closing
+	lower, upper, isRange := strings.Cut(bounds, ",")
+	lower, upper = strings.TrimSpace(lower), strings.TrimSpace(upper)
+
+	if !isRange {
+		// only "[1.0]" is valid for a single version
+		if open != "[" || closing != "]" || lower == "" {
+			return "", fmt.Errorf("invalid nuget version range %q", interval)
+		}
+		return "= " + lower, nil
+	}
+
+	if strings.Contains(upper, ",") || (lower == "" && upper == "") {
+		return "", fmt.Errorf("invalid nuget version range %q", interval)
+	}
+
+	var units []string
+	if lower != "" {
+		op := ">"
+		if open == "[" {
+			op = ">="
+		}
+		units = append(units, op+" "+lower)
+	}
+	if upper != "" {
+		op := "<"
+		if closing == "]" {
+			op = "<="
+		}
+		units = append(units, op+" "+upper)
+	}
+
+	return strings.Join(units, ", "), nil
+}
+
+func newNuGetComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newNuGetVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c nugetConstraint) supported(format Format) bool {
+	return format == NuGetFormat
+}
+
+func (c nugetConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(nuget) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.nugetVer == nil {
+		return false, fmt.Errorf("no rich nuget version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c nugetConstraint) String() string {
+	if c.raw == "" {
+		return "none (nuget)"
+	}
+	return fmt.Sprintf("%s (nuget)", c.raw)
+}
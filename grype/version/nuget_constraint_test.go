@@ -0,0 +1,55 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionNuGetConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "2.3.1", constraint: "", satisfied: true},
+		// operator expressions (as in GHSA ranges)
+		{version: "1.0.0", constraint: ">= 1.0, < 2.0", satisfied: true},
+		{version: "2.0.0.0", constraint: ">= 1.0, < 2.0", satisfied: false},
+		{version: "1.9.9.9", constraint: ">= 1.0, < 2.0", satisfied: true},
+		{version: "2.0.0-preview.1", constraint: ">= 1.0, < 2.0", satisfied: true},
+		{version: "1.0", constraint: "= 1.0.0.0", satisfied: true},
+		{version: "4.0.30319.42000", constraint: "< 4.0.30319.42001", satisfied: true},
+		// interval notation
+		{version: "1.0", constraint: "[1.0,2.0)", satisfied: true},
+		{version: "1.0.0.0", constraint: "(1.0,2.0)", satisfied: false},
+		{version: "2.0", constraint: "[1.0,2.0)", satisfied: false},
+		{version: "2.0", constraint: "[1.0,2.0]", satisfied: true},
+		{version: "0.1", constraint: "(,1.0]", satisfied: true},
+		{version: "1.0.0.1", constraint: "(,1.0]", satisfied: false},
+		{version: "99.0", constraint: "[1.0,)", satisfied: true},
+		{version: "1.0.0", constraint: "[1.0]", satisfied: true},
+		{version: "1.0.1", constraint: "[1.0]", satisfied: false},
+		{version: "1.0.0-RC1", constraint: "[1.0.0-rc1]", satisfied: true},
+		// multiple intervals
+		{version: "3.5", constraint: "[1.0,2.0), [3.0,4.0)", satisfied: true},
+		{version: "2.5", constraint: "[1.0,2.0), [3.0,4.0)", satisfied: false},
+		{version: "2.5", constraint: "[1.0,2.0) || >= 2.5, < 2.6", satisfied: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newNuGetConstraint(test.constraint)
+			require.NoError(t, err, "unexpected error from newNuGetConstraint: %v", err)
+
+			test.assertVersionConstraint(t, NuGetFormat, constraint)
+		})
+	}
+}
+
+func TestNewNuGetConstraint_Invalid(t *testing.T) {
+	for _, raw := range []string{"(1.0)", "[1.0)", "[,]", "[1.0,2.0,3.0]", "[1.0,2.0) garbage", "[1.0,2.0"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newNuGetConstraint(raw)
+			assert.Error(t, err)
+		})
+	}
+}
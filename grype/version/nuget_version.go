@@ -0,0 +1,72 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*nugetVersion)(nil)
+
+// derived from NuGet's version parsing rules, see https://learn.microsoft.com/en-us/nuget/concepts/package-versioning
+// (versions have up to four numeric parts, legacy assembly versions such as "4.0.30319.42000" use all four)
+var nugetVersionPattern = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// nugetVersion is a NuGet package version. Missing numeric parts are zero (so "1.0" == "1.0.0.0"), pre-release labels
+// are compared case-insensitively and build metadata is ignored when ordering versions.
+type nugetVersion struct {
+	raw        string
+	numbers    []int
+	preRelease []string
+}
+
+// nugetPEVersionSeparator matches the separators of versions read from PE file version resources, which may use
+// commas instead of dots (e.g. "1, 0, 0, 0").
+var nugetPEVersionSeparator = regexp.MustCompile(`\s*,\s*`)
+
+func newNuGetVersion(raw string) (*nugetVersion, error) {
+	normalized := nugetPEVersionSeparator.ReplaceAllString(strings.TrimSpace(raw), ".")
+	m := nugetVersionPattern.FindStringSubmatch(normalized)
+	if m == nil {
+		// versions read from PE metadata are free-form (e.g. "Release 73" or "1.0.0.0.1") and cannot be ordered
+		return nil, fmt.Errorf("%w: nuget version %q", ErrUnsupportedVersion, raw)
+	}
+
+	numbers := make([]int, 4)
+	for i, part := range m[1:5] {
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid nuget version %q: %w", raw, err)
+		}
+		numbers[i] = n
+	}
+
+	return &nugetVersion{
+		raw:        raw,
+		numbers:    numbers,
+		preRelease: splitSemverIdentifiers(strings.ToLower(m[5])),
+	}, nil
+}
+
+func (v *nugetVersion) Compare(other *Version) (int, error) {
+	if other.Format != NuGetFormat {
+		return -1, fmt.Errorf("unable to compare nuget to given format: %s", other.Format)
+	}
+	if other.rich.nugetVer == nil {
+		return -1, fmt.Errorf("given empty nugetVersion object")
+	}
+
+	return other.rich.nugetVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v nugetVersion) compare(v2 nugetVersion) int {
+	if c := compareSemverNumbers(v.numbers, v2.numbers); c != 0 {
+		return c
+	}
+	return compareSemverPreRelease(v.preRelease, v2.preRelease)
+}
@@ -0,0 +1,65 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionNuGet(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		// missing parts are zero
+		{"1.0", "1.0.0.0", 0},
+		{"1", "1.0.0", 0},
+		{"2.1", "2.1.0.1", -1},
+		// four-part (assembly) versions
+		{"4.0.30319.42000", "4.0.30319.1", 1},
+		{"4.6.1055.0", "4.6.1055", 0},
+		{"1.2.3.4", "1.2.4", -1},
+		// pre-release labels are compared case-insensitively and sort before the release
+		{"1.0.0-RC1", "1.0.0-rc1", 0},
+		{"1.0.0-Beta", "1.0.0-alpha", 1},
+		{"1.0.0-rc.1", "1.0.0", -1},
+		{"1.0.0.1-beta", "1.0.0.1", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		// build metadata is ignored
+		{"1.0.0+abc", "1.0.0+def", 0},
+		{"1.0.0-rc.1+abc", "1.0.0-RC.1", 0},
+		{"v1.0.0", "1.0.0", 0},
+		// versions from PE file version resources
+		{"1, 0, 0, 0", "1.0.0.0", 0},
+		{"4,6,1055,0", "4.6.1055.1", -1},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newNuGetVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newNuGetVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewNuGetVersion_Invalid(t *testing.T) {
+	// includes free-form versions read from PE metadata
+	for _, raw := range []string{"", "1.0.0.0.0", "1.0.0.0.1", "1.0-", "not a version", "Release 73"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newNuGetVersion(raw)
+			assert.ErrorIs(t, err, ErrUnsupportedVersion)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package version
+
+import (
+	"fmt"
+)
+
+type pacmanConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newPacmanConstraint(raw string) (pacmanConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return pacmanConstraint{}, nil
+	}
+
+	constraints, err := newConstraintExpression(raw, newPacmanComparator)
+	if err != nil {
+		return pacmanConstraint{}, fmt.Errorf("unable to parse pacman constraint phrase: %w", err)
+	}
+
+	return pacmanConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+func newPacmanComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newPacmanVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c pacmanConstraint) supported(format Format) bool {
+	return format == PacmanFormat
+}
+
+func (c pacmanConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(pacman) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.pacmanVer == nil {
+		return false, fmt.Errorf("no rich pacman version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c pacmanConstraint) String() string {
+	if c.raw == "" {
+		return "none (pacman)"
+	}
+	return fmt.Sprintf("%s (pacman)", c.raw)
+}
@@ -0,0 +1,36 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionPacmanConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "2.3.1-1", constraint: "", satisfied: true},
+		// typical security tracker fixed versions
+		{version: "3.0.7-1", constraint: "< 3.0.8-1", satisfied: true},
+		{version: "3.0.8-1", constraint: "< 3.0.8-1", satisfied: false},
+		{version: "3.0.8-2", constraint: "< 3.0.8-1", satisfied: false},
+		{version: "3.0.8-1", constraint: "< 3.0.8-2", satisfied: true},
+		// epochs
+		{version: "1:1.0-1", constraint: "< 2.0-1", satisfied: false},
+		{version: "1:1.0-1", constraint: "< 1:1.1-1", satisfied: true},
+		// pkgrel is ignored when not on both sides
+		{version: "1.5-3", constraint: "<= 1.5", satisfied: true},
+		// ranges
+		{version: "8.4.0-1", constraint: ">= 8.0.0, < 8.5.0-1 || >= 9.0.0, < 9.1.0-1", satisfied: true},
+		{version: "9.1.0-1", constraint: ">= 8.0.0, < 8.5.0-1 || >= 9.0.0, < 9.1.0-1", satisfied: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newPacmanConstraint(test.constraint)
+			assert.NoError(t, err, "unexpected error from newPacmanConstraint: %v", err)
+
+			test.assertVersionConstraint(t, PacmanFormat, constraint)
+		})
+	}
+}
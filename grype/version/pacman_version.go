@@ -0,0 +1,189 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ Comparator = (*pacmanVersion)(nil)
+
+// pacmanVersion is an Arch Linux (alpm) package version of the form "epoch:pkgver-pkgrel", where the epoch and pkgrel
+// are optional. Comparison follows alpm_pkg_vercmp() from libalpm (what "vercmp" and pacman use), see
+// https://gitlab.archlinux.org/pacman/pacman/-/blob/master/lib/libalpm/version.c
+type pacmanVersion struct {
+	raw     string
+	epoch   string
+	version string
+	// release is the pkgrel, which is only compared when both versions have one
+	release    string
+	hasRelease bool
+}
+
+func newPacmanVersion(raw string) (*pacmanVersion, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, fmt.Errorf("unable to parse empty pacman version")
+	}
+
+	v := &pacmanVersion{
+		raw:     raw,
+		epoch:   "0",
+		version: raw,
+	}
+
+	// the epoch is the leading digits before a ":" (unlike rpm, a missing epoch is always 0)
+	digits := 0
+	for digits < len(raw) && isASCIIDigit(raw[digits]) {
+		digits++
+	}
+	if digits < len(raw) && raw[digits] == ':' {
+		if digits > 0 {
+			v.epoch = raw[:digits]
+		}
+		v.version = raw[digits+1:]
+	}
+
+	// the release is everything after the last "-"
+	if idx := strings.LastIndex(v.version, "-"); idx >= 0 {
+		v.release = v.version[idx+1:]
+		v.version = v.version[:idx]
+		v.hasRelease = true
+	}
+
+	return v, nil
+}
+
+func (v *pacmanVersion) Compare(other *Version) (int, error) {
+	if other.Format != PacmanFormat {
+		return -1, fmt.Errorf("unable to compare pacman to given format: %s", other.Format)
+	}
+	if other.rich.pacmanVer == nil {
+		return -1, fmt.Errorf("given empty pacmanVersion object")
+	}
+
+	return other.rich.pacmanVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v pacmanVersion) compare(v2 pacmanVersion) int {
+	if v.raw == v2.raw {
+		return 0
+	}
+
+	if c := pacmanSegmentCompare(v.epoch, v2.epoch); c != 0 {
+		return c
+	}
+
+	if c := pacmanSegmentCompare(v.version, v2.version); c != 0 {
+		return c
+	}
+
+	// the release is only considered when present on both sides (e.g. "1.5" == "1.5-1")
+	if v.hasRelease && v2.hasRelease {
+		return pacmanSegmentCompare(v.release, v2.release)
+	}
+	return 0
+}
+
+// pacmanSegmentCompare is a port of rpmvercmp() as implemented by libalpm, which differs from the rpm implementation
+// (e.g. there is no special handling of "~" and "^", and differing separator lengths are significant).
+//
+//nolint:gocognit,funlen
+func pacmanSegmentCompare(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	// one / two are the start of the current segment, ptr1 / ptr2 are the end of the previous segment
+	one, two := 0, 0
+	ptr1, ptr2 := 0, 0
+
+	for one < len(a) && two < len(b) {
+		for one < len(a) && !isASCIIAlnum(a[one]) {
+			one++
+		}
+		for two < len(b) && !isASCIIAlnum(b[two]) {
+			two++
+		}
+
+		// if we ran to the end of either, we are finished with the loop
+		if one >= len(a) || two >= len(b) {
+			break
+		}
+
+		// if the separator lengths were different, we are also finished
+		if one-ptr1 != two-ptr2 {
+			if one-ptr1 < two-ptr2 {
+				return -1
+			}
+			return 1
+		}
+
+		ptr1, ptr2 = one, two
+
+		// grab the first completely alpha or completely numeric segment
+		isNum := isASCIIDigit(a[ptr1])
+		matches := isASCIIAlpha
+		if isNum {
+			matches = isASCIIDigit
+		}
+		for ptr1 < len(a) && matches(a[ptr1]) {
+			ptr1++
+		}
+		for ptr2 < len(b) && matches(b[ptr2]) {
+			ptr2++
+		}
+
+		// numeric segments are always newer than alpha segments
+		if two == ptr2 {
+			if isNum {
+				return 1
+			}
+			return -1
+		}
+
+		seg1, seg2 := a[one:ptr1], b[two:ptr2]
+		if isNum {
+			// compare numbers without converting them (which could overflow): ignoring leading zeros, the number
+			// with more digits is larger
+			seg1 = strings.TrimLeft(seg1, "0")
+			seg2 = strings.TrimLeft(seg2, "0")
+			if len(seg1) != len(seg2) {
+				if len(seg1) > len(seg2) {
+					return 1
+				}
+				return -1
+			}
+		}
+
+		if c := strings.Compare(seg1, seg2); c != 0 {
+			return c
+		}
+
+		one, two = ptr1, ptr2
+	}
+
+	// all segments compared identically, but the separators may have differed
+	if one >= len(a) && two >= len(b) {
+		return 0
+	}
+
+	// the final showdown: a remaining alpha string never beats an empty string, so if a is empty and b is not alpha
+	// (or if a is alpha), b is newer. Otherwise, a is newer.
+	if (one >= len(a) && !isASCIIAlpha(b[two])) || (one < len(a) && isASCIIAlpha(a[one])) {
+		return -1
+	}
+	return 1
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isASCIIAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isASCIIAlnum(c byte) bool {
+	return isASCIIDigit(c) || isASCIIAlpha(c)
+}
@@ -0,0 +1,112 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cases are ported from pacman's vercmp tests, see
+// https://gitlab.archlinux.org/pacman/pacman/-/blob/master/test/util/vercmptest.sh
+func TestVersionPacman(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		// all similar length, no pkgrel
+		{"1.5.0", "1.5.0", 0},
+		{"1.5.1", "1.5.0", 1},
+		// mixed length
+		{"1.5.1", "1.5", 1},
+		// with pkgrel, simple
+		{"1.5.0-1", "1.5.0-1", 0},
+		{"1.5.0-1", "1.5.0-2", -1},
+		{"1.5.0-1", "1.5.1-1", -1},
+		{"1.5.0-2", "1.5.1-1", -1},
+		// with pkgrel, mixed lengths
+		{"1.5-1", "1.5.1-1", -1},
+		{"1.5-2", "1.5.1-1", -1},
+		{"1.5-2", "1.5.1-2", -1},
+		// mixed pkgrel inclusion
+		{"1.5", "1.5-1", 0},
+		{"1.5-1", "1.5", 0},
+		{"1.1-1", "1.1", 0},
+		{"1.0-1", "1.1", -1},
+		{"1.1-1", "1.0", 1},
+		// alphanumeric versions
+		{"1.5b-1", "1.5-1", -1},
+		{"1.5b", "1.5", -1},
+		{"1.5b-1", "1.5", -1},
+		{"1.5b", "1.5.1", -1},
+		// from the manpage
+		{"1.0a", "1.0alpha", -1},
+		{"1.0alpha", "1.0b", -1},
+		{"1.0b", "1.0beta", -1},
+		{"1.0beta", "1.0rc", -1},
+		{"1.0rc", "1.0", -1},
+		// going crazy? alpha-dotted versions
+		{"1.5.a", "1.5", 1},
+		{"1.5.b", "1.5.a", 1},
+		{"1.5.1", "1.5.b", 1},
+		// alpha dots and dashes
+		{"1.5.b-1", "1.5.b", 0},
+		{"1.5-1", "1.5.b", -1},
+		// same/similar content, differing separators
+		{"2.0", "2_0", 0},
+		{"2.0_a", "2_0.a", 0},
+		{"2.0a", "2.0.a", -1},
+		{"2___a", "2_a", 1},
+		// epoch included version comparisons
+		{"0:1.0", "0:1.0", 0},
+		{"0:1.0", "0:1.1", -1},
+		{"1:1.0", "0:1.0", 1},
+		{"1:1.0", "0:1.1", 1},
+		{"1:1.0", "2:1.1", -1},
+		// epoch + sometimes present pkgrel
+		{"1:1.0", "0:1.0-1", 1},
+		{"1:1.0-1", "0:1.1-1", 1},
+		// epoch included on one version
+		{"0:1.0", "1.0", 0},
+		{"0:1.0", "1.1", -1},
+		{"0:1.1", "1.0", 1},
+		{"1:1.0", "1.0", 1},
+		{"1:1.0", "1.1", 1},
+		{"1:1.1", "1.1", 1},
+		// large numbers do not overflow
+		{"20240101000000000000000", "20240101000000000000001", -1},
+		{"1.007", "1.7", 0},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newPacmanVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newPacmanVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewPacmanVersion(t *testing.T) {
+	v, err := newPacmanVersion("2:1.2.3-4")
+	require.NoError(t, err)
+	assert.Equal(t, "2", v.epoch)
+	assert.Equal(t, "1.2.3", v.version)
+	assert.Equal(t, "4", v.release)
+
+	v, err = newPacmanVersion(":1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, "0", v.epoch)
+	assert.Equal(t, "1.2.3", v.version)
+	assert.False(t, v.hasRelease)
+
+	_, err = newPacmanVersion("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// pubCaretPattern matches caret constraints (e.g. "^1.2.3"), which allow any version that is backwards compatible
+var pubCaretPattern = regexp.MustCompile(`\^\s*(\d+)\.(\d+)\.(\d+)([^\s,|]*)`)
+
+type pubConstraint struct {
+	raw        string
+	expression constraintExpression
+}
+
+func newPubConstraint(raw string) (pubConstraint, error) {
+	if raw == "" {
+		// an empty constraint is always satisfied
+		return pubConstraint{}, nil
+	}
+
+	constraints, err := newConstraintExpression(expandPubCaretConstraints(raw), newPubComparator)
+	if err != nil {
+		return pubConstraint{}, fmt.Errorf("unable to parse pub constraint phrase: %w", err)
+	}
+
+	return pubConstraint{
+		raw:        raw,
+		expression: constraints,
+	}, nil
+}
+
+// expandPubCaretConstraints rewrites caret constraints into ranges: "^1.2.3" is ">=1.2.3, <2.0.0-0" and (for versions
+// before 1.0.0) "^0.2.3" is ">=0.2.3, <0.3.0-0". The upper bound excludes pre-releases of the next incompatible version.
+func expandPubCaretConstraints(raw string) string {
+	return pubCaretPattern.ReplaceAllStringFunc(raw, func(s string) string {
+		m := pubCaretPattern.FindStringSubmatch(s)
+		// the pattern only matches digits for the major and minor parts
+		major, _ := strconv.Atoi(m[1])
+		minor, _ := strconv.Atoi(m[2])
+
+		upper := fmt.Sprintf("%d.0.0-0", major+1)
+		if major == 0 {
+			upper = fmt.Sprintf("0.%d.0-0", minor+1)
+		}
+		return fmt.Sprintf(">= %s.%s.%s%s, < %s", m[1], m[2], m[3], m[4], upper)
+	})
+}
+
+func newPubComparator(unit constraintUnit) (Comparator, error) {
+	ver, err := newPubVersion(unit.version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse constraint version (%s): %w", unit.version, err)
+	}
+	return ver, nil
+}
+
+func (c pubConstraint) supported(format Format) bool {
+	return format == PubFormat
+}
+
+func (c pubConstraint) Satisfied(version *Version) (bool, error) {
+	if c.raw == "" && version != nil {
+		// an empty constraint is always satisfied
+		return true, nil
+	} else if version == nil {
+		if c.raw != "" {
+			// a non-empty constraint with no version given should always fail
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !c.supported(version.Format) {
+		return false, fmt.Errorf("(pub) unsupported format: %s", version.Format)
+	}
+
+	if version.rich.pubVer == nil {
+		return false, fmt.Errorf("no rich pub version given: %+v", version)
+	}
+
+	return c.expression.satisfied(version)
+}
+
+func (c pubConstraint) String() string {
+	if c.raw == "" {
+		return "none (pub)"
+	}
+	return fmt.Sprintf("%s (pub)", c.raw)
+}
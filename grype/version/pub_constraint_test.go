@@ -0,0 +1,39 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionPubConstraint(t *testing.T) {
+	tests := []testCase{
+		// empty values
+		{version: "2.3.1", constraint: "", satisfied: true},
+		// typical GHSA ranges
+		{version: "0.13.5", constraint: "< 0.13.6", satisfied: true},
+		{version: "0.13.6", constraint: "< 0.13.6", satisfied: false},
+		{version: "1.2.0", constraint: ">= 1.0.0, < 1.2.1 || >= 2.0.0, < 2.0.3", satisfied: true},
+		{version: "2.0.3", constraint: ">= 1.0.0, < 1.2.1 || >= 2.0.0, < 2.0.3", satisfied: false},
+		// builds
+		{version: "1.2.1+1", constraint: "<= 1.2.1", satisfied: false},
+		{version: "1.2.1+1", constraint: "< 1.2.1+2", satisfied: true},
+		{version: "1.2.1-dev", constraint: "< 1.2.1", satisfied: true},
+		// caret constraints
+		{version: "1.9.0", constraint: "^1.2.3", satisfied: true},
+		{version: "1.2.2", constraint: "^1.2.3", satisfied: false},
+		{version: "2.0.0", constraint: "^1.2.3", satisfied: false},
+		{version: "2.0.0-dev", constraint: "^1.2.3", satisfied: false},
+		{version: "0.2.9", constraint: "^0.2.3", satisfied: true},
+		{version: "0.3.0", constraint: "^0.2.3", satisfied: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tName(), func(t *testing.T) {
+			constraint, err := newPubConstraint(test.constraint)
+			assert.NoError(t, err, "unexpected error from newPubConstraint: %v", err)
+
+			test.assertVersionConstraint(t, PubFormat, constraint)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var _ Comparator = (*pubVersion)(nil)
+
+// derived from the pub_semver package, see https://github.com/dart-lang/pub_semver/blob/master/lib/src/version.dart
+var pubVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`)
+
+// pubVersion is a Dart Pub package version. Pub versions follow semver with the exception that build metadata is
+// considered when ordering versions (e.g. "1.0.0" < "1.0.0+1" < "1.0.0+2").
+type pubVersion struct {
+	raw        string
+	major      int
+	minor      int
+	patch      int
+	preRelease []string
+	build      []string
+}
+
+func newPubVersion(raw string) (*pubVersion, error) {
+	m := pubVersionPattern.FindStringSubmatch(strings.TrimSpace(raw))
+	if m == nil {
+		return nil, fmt.Errorf("unable to parse pub version: %q", raw)
+	}
+
+	var numbers [3]int
+	for i := range numbers {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pub version %q: %w", raw, err)
+		}
+		numbers[i] = n
+	}
+
+	return &pubVersion{
+		raw:        raw,
+		major:      numbers[0],
+		minor:      numbers[1],
+		patch:      numbers[2],
+		preRelease: splitSemverIdentifiers(m[4]),
+		build:      splitSemverIdentifiers(m[5]),
+	}, nil
+}
+
+func (v *pubVersion) Compare(other *Version) (int, error) {
+	if other.Format != PubFormat {
+		return -1, fmt.Errorf("unable to compare pub to given format: %s", other.Format)
+	}
+	if other.rich.pubVer == nil {
+		return -1, fmt.Errorf("given empty pubVersion object")
+	}
+
+	return other.rich.pubVer.compare(*v), nil
+}
+
+// compare returns 0 if v == v2, -1 if v < v2, and +1 if v > v2.
+func (v pubVersion) compare(v2 pubVersion) int {
+	if c := compareSemverNumbers([]int{v.major, v.minor, v.patch}, []int{v2.major, v2.minor, v2.patch}); c != 0 {
+		return c
+	}
+
+	// a pre-release sorts before the release...
+	if c := compareSemverPreRelease(v.preRelease, v2.preRelease); c != 0 {
+		return c
+	}
+
+	// ...while a build sorts after it
+	switch {
+	case len(v.build) == 0 && len(v2.build) == 0:
+		return 0
+	case len(v.build) == 0:
+		return -1
+	case len(v2.build) == 0:
+		return 1
+	}
+	return compareSemverIdentifiers(v.build, v2.build)
+}
+
+func splitSemverIdentifiers(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ".")
+}
+
+func compareSemverNumbers(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// compareSemverPreRelease compares two lists of pre-release identifiers, where a release (no identifiers) sorts after
+// any of its pre-releases.
+func compareSemverPreRelease(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	return compareSemverIdentifiers(a, b)
+}
+
+// compareSemverIdentifiers compares dot-separated identifiers per semver precedence rules: numeric identifiers are
+// compared numerically and sort before alphanumeric identifiers, which are compared lexically. A list that is a prefix
+// of the other sorts first.
+func compareSemverIdentifiers(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		aNum, aErr := semverNumericIdentifier(a[i])
+		bNum, bErr := semverNumericIdentifier(b[i])
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				if aNum < bNum {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// semverNumericIdentifier returns the value of an identifier made only of digits (so that identifiers such as "-1" or
+// "+1" are alphanumeric).
+func semverNumericIdentifier(s string) (int, error) {
+	for i := 0; i < len(s); i++ {
+		if !isASCIIDigit(s[i]) {
+			return 0, fmt.Errorf("not a numeric identifier: %q", s)
+		}
+	}
+	return strconv.Atoi(s)
+}
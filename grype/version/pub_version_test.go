@@ -0,0 +1,57 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionPub(t *testing.T) {
+	tests := []struct {
+		v1     string
+		v2     string
+		result int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.0.0", "1.99.99", 1},
+		// pre-releases sort before the release
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.11", 1},
+		// builds sort after the release (unlike semver, where builds are ignored)
+		{"1.0.0", "1.0.0+1", -1},
+		{"1.0.0+1", "1.0.0+2", -1},
+		{"1.0.0+build.10", "1.0.0+build.9", 1},
+		{"1.0.0+1", "1.0.0+1", 0},
+		{"1.0.0-dev+1", "1.0.0", -1},
+		{"1.0.0+1", "1.0.1", -1},
+	}
+
+	for _, test := range tests {
+		name := test.v1 + "_vs_" + test.v2
+		t.Run(name, func(t *testing.T) {
+			v1, err := newPubVersion(test.v1)
+			require.NoError(t, err)
+
+			v2, err := newPubVersion(test.v2)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.result, v1.compare(*v2))
+			assert.Equal(t, -test.result, v2.compare(*v1))
+		})
+	}
+}
+
+func TestNewPubVersion_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "1.0", "v1.0.0", "1.0.0-", "not a version"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := newPubVersion(raw)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -29,6 +29,14 @@ type rich struct {
 	portVer       *portageVersion
 	pep440version *pep440Version
 	jvmVersion    *jvmVersion
+	composerVer   *composerVersion
+	pubVer        *pubVersion
+	hexVer        *hexVersion
+	pacmanVer     *pacmanVersion
+	nugetVer      *nugetVersion
+	npmVer        *npmVersion
+	kernelVer     *kernelVersion
+	nixVer        *nixVersion
 }
 
 func NewVersion(raw string, format Format) (*Version, error) {
@@ -103,6 +111,38 @@ func (v *Version) populate() error {
 		ver, err := newJvmVersion(v.Raw)
 		v.rich.jvmVersion = ver
 		return err
+	case ComposerFormat:
+		ver, err := newComposerVersion(v.Raw)
+		v.rich.composerVer = ver
+		return err
+	case PubFormat:
+		ver, err := newPubVersion(v.Raw)
+		v.rich.pubVer = ver
+		return err
+	case HexFormat:
+		ver, err := newHexVersion(v.Raw)
+		v.rich.hexVer = ver
+		return err
+	case PacmanFormat:
+		ver, err := newPacmanVersion(v.Raw)
+		v.rich.pacmanVer = ver
+		return err
+	case NuGetFormat:
+		ver, err := newNuGetVersion(v.Raw)
+		v.rich.nugetVer = ver
+		return err
+	case NpmFormat:
+		ver, err := newNpmVersion(v.Raw)
+		v.rich.npmVer = ver
+		return err
+	case KernelFormat:
+		ver, err := newKernelVersion(v.Raw)
+		v.rich.kernelVer = ver
+		return err
+	case NixFormat:
+		ver, err := newNixVersion(v.Raw)
+		v.rich.nixVer = ver
+		return err
 	case UnknownFormat:
 		// use the raw string + fuzzy constraint
 		return nil
@@ -0,0 +1,298 @@
+package csaf
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	openvex "github.com/openvex/go-vex/pkg/vex"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/vex/internal"
+	grypeVulnerability "github.com/anchore/grype/grype/vulnerability"
+)
+
+type Processor struct{}
+
+func New() *Processor {
+	return &Processor{}
+}
+
+// IsCSAF indicates if the given document contents are a CSAF advisory.
+func IsCSAF(contents []byte) bool {
+	var doc struct {
+		Document struct {
+			CSAFVersion string `json:"csaf_version"`
+		} `json:"document"`
+	}
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return false
+	}
+	return doc.Document.CSAFVersion != ""
+}
+
+// ReadVexDocuments reads the product status of every vulnerability in each CSAF advisory into a single set of
+// VEX statements
+func (p *Processor) ReadVexDocuments(docs []string) (interface{}, error) {
+	out := &internal.Documents{}
+	for _, doc := range docs {
+		statements, err := readDocument(doc)
+		if err != nil {
+			return nil, fmt.Errorf("reading csaf vex document %q: %w", doc, err)
+		}
+		out.Statements = append(out.Statements, statements...)
+	}
+	return out, nil
+}
+
+func readDocument(path string) ([]internal.Statement, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var adv advisory
+	if err := json.NewDecoder(bytes.NewReader(contents)).Decode(&adv); err != nil {
+		return nil, fmt.Errorf("unable to decode advisory: %w", err)
+	}
+
+	if adv.Document.CSAFVersion == "" {
+		return nil, errors.New("document is not a CSAF advisory")
+	}
+
+	purls := adv.ProductTree.packageURLs()
+
+	var statements []internal.Statement
+	for _, v := range adv.Vulnerabilities {
+		id, aliases := v.identifiers()
+		if id == "" {
+			continue
+		}
+
+		justifications := v.justifications()
+
+		for _, group := range v.ProductStatus.groups() {
+			var products []string
+			for _, productID := range group.productIDs {
+				products = append(products, purls[productID]...)
+			}
+			if len(products) == 0 {
+				continue
+			}
+
+			s := internal.Statement{
+				Vulnerability: id,
+				Aliases:       aliases,
+				Products:      products,
+				Status:        group.status,
+				Document:      path,
+			}
+
+			if group.status == openvex.StatusNotAffected {
+				for _, productID := range group.productIDs {
+					if j, ok := justifications[productID]; ok {
+						s.Justification = j
+						break
+					}
+				}
+			}
+
+			if len(v.Notes) > 0 {
+				s.Detail = v.Notes[0].Text
+			}
+
+			statements = append(statements, s)
+		}
+	}
+
+	return statements, nil
+}
+
+// advisory is the subset of a CSAF 2.0 document needed to derive VEX statements
+// (see https://docs.oasis-open.org/csaf/csaf/v2.0/csaf-v2.0.html)
+type advisory struct {
+	Document struct {
+		CSAFVersion string `json:"csaf_version"`
+	} `json:"document"`
+	ProductTree     productTree     `json:"product_tree"`
+	Vulnerabilities []vulnerability `json:"vulnerabilities"`
+}
+
+type productTree struct {
+	Branches         []branch          `json:"branches"`
+	FullProductNames []fullProductName `json:"full_product_names"`
+	Relationships    []relationship    `json:"relationships"`
+}
+
+type branch struct {
+	Branches []branch         `json:"branches"`
+	Product  *fullProductName `json:"product"`
+}
+
+type fullProductName struct {
+	ProductID                   string `json:"product_id"`
+	ProductIdentificationHelper *struct {
+		PURL string `json:"purl"`
+	} `json:"product_identification_helper"`
+}
+
+type relationship struct {
+	ProductReference          string          `json:"product_reference"`
+	RelatesToProductReference string          `json:"relates_to_product_reference"`
+	FullProductName           fullProductName `json:"full_product_name"`
+}
+
+type vulnerability struct {
+	CVE string `json:"cve"`
+	IDs []struct {
+		Text string `json:"text"`
+	} `json:"ids"`
+	Flags []struct {
+		Label      string   `json:"label"`
+		ProductIDs []string `json:"product_ids"`
+	} `json:"flags"`
+	Notes []struct {
+		Category string `json:"category"`
+		Text     string `json:"text"`
+	} `json:"notes"`
+	ProductStatus productStatus `json:"product_status"`
+}
+
+type productStatus struct {
+	FirstAffected      []string `json:"first_affected"`
+	FirstFixed         []string `json:"first_fixed"`
+	Fixed              []string `json:"fixed"`
+	KnownAffected      []string `json:"known_affected"`
+	KnownNotAffected   []string `json:"known_not_affected"`
+	LastAffected       []string `json:"last_affected"`
+	UnderInvestigation []string `json:"under_investigation"`
+}
+
+type statusGroup struct {
+	status     openvex.Status
+	productIDs []string
+}
+
+// groups returns the products for each CSAF product status category, mapped to the equivalent OpenVEX status
+func (ps productStatus) groups() []statusGroup {
+	concat := func(lists ...[]string) []string {
+		var out []string
+		for _, l := range lists {
+			out = append(out, l...)
+		}
+		return out
+	}
+
+	return []statusGroup{
+		{status: openvex.StatusNotAffected, productIDs: ps.KnownNotAffected},
+		{status: openvex.StatusFixed, productIDs: concat(ps.Fixed, ps.FirstFixed)},
+		{status: openvex.StatusAffected, productIDs: concat(ps.KnownAffected, ps.FirstAffected, ps.LastAffected)},
+		{status: openvex.StatusUnderInvestigation, productIDs: ps.UnderInvestigation},
+	}
+}
+
+// identifiers returns the primary vulnerability ID (preferring the CVE) and any other IDs listed in the advisory
+func (v vulnerability) identifiers() (string, []string) {
+	id := v.CVE
+	var aliases []string
+	for _, other := range v.IDs {
+		if other.Text == "" || other.Text == id {
+			continue
+		}
+		if id == "" {
+			id = other.Text
+			continue
+		}
+		aliases = append(aliases, other.Text)
+	}
+	return id, aliases
+}
+
+// justifications returns the justification flag by product ID. CSAF flag labels share the OpenVEX vocabulary.
+func (v vulnerability) justifications() map[string]openvex.Justification {
+	out := make(map[string]openvex.Justification)
+	for _, f := range v.Flags {
+		j := openvex.Justification(f.Label)
+		if !j.Valid() {
+			continue
+		}
+		for _, productID := range f.ProductIDs {
+			out[productID] = j
+		}
+	}
+	return out
+}
+
+// packageURLs returns the package URLs for every product ID in the product tree. Products defined by a relationship
+// (e.g. a package installed on an OS) resolve to the package URL of the referenced product.
+func (pt productTree) packageURLs() map[string][]string {
+	out := make(map[string][]string)
+
+	add := func(p fullProductName) {
+		if p.ProductID == "" || p.ProductIdentificationHelper == nil || p.ProductIdentificationHelper.PURL == "" {
+			return
+		}
+		out[p.ProductID] = append(out[p.ProductID], p.ProductIdentificationHelper.PURL)
+	}
+
+	var visit func(branches []branch)
+	visit = func(branches []branch) {
+		for _, b := range branches {
+			if b.Product != nil {
+				add(*b.Product)
+			}
+			visit(b.Branches)
+		}
+	}
+
+	visit(pt.Branches)
+	for _, p := range pt.FullProductNames {
+		add(p)
+	}
+
+	for _, r := range pt.Relationships {
+		id := r.FullProductName.ProductID
+		if id == "" {
+			continue
+		}
+		if _, ok := out[id]; ok {
+			continue
+		}
+		if purls, ok := out[r.ProductReference]; ok {
+			out[id] = purls
+		}
+	}
+
+	return out
+}
+
+// FilterMatches takes a set of scanning results and moves any results marked in
+// the VEX data as fixed or not_affected to the ignored list.
+func (p *Processor) FilterMatches(
+	docRaw interface{}, ignoreRules []match.IgnoreRule, metadataProvider grypeVulnerability.MetadataProvider, _ *pkg.Context, matches *match.Matches, ignoredMatches []match.IgnoredMatch,
+) (*match.Matches, []match.IgnoredMatch, error) {
+	docs, ok := docRaw.(*internal.Documents)
+	if !ok {
+		return nil, nil, errors.New("unable to cast vex document as csaf")
+	}
+
+	remainingMatches, ignoredMatches := internal.FilterMatches(docs, ignoreRules, metadataProvider, match.CSAFVexMatcher, matches, ignoredMatches)
+	return remainingMatches, ignoredMatches, nil
+}
+
+// AugmentMatches adds results to the match.Matches array when matching data
+// about an affected or under investigation package is found on loaded VEX documents.
+func (p *Processor) AugmentMatches(
+	docRaw interface{}, ignoreRules []match.IgnoreRule, metadataProvider grypeVulnerability.MetadataProvider, _ *pkg.Context, remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch,
+) (*match.Matches, []match.IgnoredMatch, error) {
+	docs, ok := docRaw.(*internal.Documents)
+	if !ok {
+		return nil, nil, errors.New("unable to cast vex document as csaf")
+	}
+
+	remainingMatches, ignoredMatches = internal.AugmentMatches(docs, ignoreRules, metadataProvider, match.CSAFVexMatcher, remainingMatches, ignoredMatches)
+	return remainingMatches, ignoredMatches, nil
+}
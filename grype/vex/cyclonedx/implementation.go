@@ -0,0 +1,217 @@
+package cyclonedx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	openvex "github.com/openvex/go-vex/pkg/vex"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/vex/internal"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+type Processor struct{}
+
+func New() *Processor {
+	return &Processor{}
+}
+
+// statuses maps CycloneDX impact analysis states to the equivalent OpenVEX status
+var statuses = map[cdx.ImpactAnalysisState]openvex.Status{
+	cdx.IASNotAffected:          openvex.StatusNotAffected,
+	cdx.IASFalsePositive:        openvex.StatusNotAffected,
+	cdx.IASResolved:             openvex.StatusFixed,
+	cdx.IASResolvedWithPedigree: openvex.StatusFixed,
+	cdx.IASExploitable:          openvex.StatusAffected,
+	cdx.IASInTriage:             openvex.StatusUnderInvestigation,
+}
+
+// justifications maps CycloneDX impact analysis justifications to the closest OpenVEX justification
+var justifications = map[cdx.ImpactAnalysisJustification]openvex.Justification{
+	cdx.IAJCodeNotPresent:               openvex.VulnerableCodeNotPresent,
+	cdx.IAJCodeNotReachable:             openvex.VulnerableCodeNotInExecutePath,
+	cdx.IAJRequiresConfiguration:        openvex.VulnerableCodeCannotBeControlledByAdversary,
+	cdx.IAJRequiresDependency:           openvex.VulnerableCodeCannotBeControlledByAdversary,
+	cdx.IAJRequiresEnvironment:          openvex.VulnerableCodeCannotBeControlledByAdversary,
+	cdx.IAJProtectedByCompiler:          openvex.InlineMitigationsAlreadyExist,
+	cdx.IAJProtectedAtRuntime:           openvex.InlineMitigationsAlreadyExist,
+	cdx.IAJProtectedAtPerimeter:         openvex.InlineMitigationsAlreadyExist,
+	cdx.IAJProtectedByMitigatingControl: openvex.InlineMitigationsAlreadyExist,
+}
+
+// IsCycloneDX indicates if the given document contents are a CycloneDX BOM (JSON or XML).
+func IsCycloneDX(contents []byte) bool {
+	trimmed := bytes.TrimSpace(contents)
+	if bytes.HasPrefix(trimmed, []byte("<")) {
+		return bytes.Contains(trimmed, []byte("http://cyclonedx.org/schema/bom"))
+	}
+	return bytes.Contains(trimmed, []byte(`"bomFormat"`)) && bytes.Contains(trimmed, []byte(`"CycloneDX"`))
+}
+
+// ReadVexDocuments reads the vulnerability analysis from each CycloneDX BOM into a single set of VEX statements
+func (p *Processor) ReadVexDocuments(docs []string) (interface{}, error) {
+	out := &internal.Documents{}
+	for _, doc := range docs {
+		statements, err := readDocument(doc)
+		if err != nil {
+			return nil, fmt.Errorf("reading cyclonedx vex document %q: %w", doc, err)
+		}
+		out.Statements = append(out.Statements, statements...)
+	}
+	return out, nil
+}
+
+func readDocument(path string) ([]internal.Statement, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	format := cdx.BOMFileFormatJSON
+	if bytes.HasPrefix(bytes.TrimSpace(contents), []byte("<")) {
+		format = cdx.BOMFileFormatXML
+	}
+
+	var bom cdx.BOM
+	if err := cdx.NewBOMDecoder(bytes.NewReader(contents), format).Decode(&bom); err != nil {
+		return nil, fmt.Errorf("unable to decode BOM: %w", err)
+	}
+
+	purlsByRef := indexPackageURLs(bom)
+
+	var statements []internal.Statement
+	if bom.Vulnerabilities == nil {
+		return nil, nil
+	}
+	for _, v := range *bom.Vulnerabilities {
+		if v.Analysis == nil || v.Affects == nil {
+			continue
+		}
+
+		status, ok := statuses[v.Analysis.State]
+		if !ok {
+			continue
+		}
+
+		var products []string
+		for _, affected := range *v.Affects {
+			if purl := resolveRef(affected.Ref, purlsByRef); purl != "" {
+				products = append(products, purl)
+			}
+		}
+		if len(products) == 0 {
+			continue
+		}
+
+		var aliases []string
+		if v.References != nil {
+			for _, ref := range *v.References {
+				if ref.ID != "" {
+					aliases = append(aliases, ref.ID)
+				}
+			}
+		}
+
+		s := internal.Statement{
+			Vulnerability: v.ID,
+			Aliases:       aliases,
+			Products:      products,
+			Status:        status,
+			Detail:        v.Analysis.Detail,
+			Document:      path,
+		}
+		if status == openvex.StatusNotAffected {
+			s.Justification = justifications[v.Analysis.Justification]
+		}
+
+		statements = append(statements, s)
+	}
+
+	return statements, nil
+}
+
+// indexPackageURLs returns the package URL of every component in the BOM (including the subject of the BOM) by BOM reference
+func indexPackageURLs(bom cdx.BOM) map[string]string {
+	out := make(map[string]string)
+
+	var visit func(components *[]cdx.Component)
+	add := func(c cdx.Component) {
+		if c.BOMRef != "" && c.PackageURL != "" {
+			out[c.BOMRef] = c.PackageURL
+		}
+		visit(c.Components)
+	}
+	visit = func(components *[]cdx.Component) {
+		if components == nil {
+			return
+		}
+		for _, c := range *components {
+			add(c)
+		}
+	}
+
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		add(*bom.Metadata.Component)
+	}
+	visit(bom.Components)
+
+	return out
+}
+
+// resolveRef returns the package URL for a vulnerability "affects" reference, which may be a package URL itself, a
+// local BOM reference, or a BOM-Link to a component in another BOM (urn:cdx:<serial>/<version>#<bom-ref>).
+func resolveRef(ref string, purlsByRef map[string]string) string {
+	if purl, ok := purlsByRef[ref]; ok {
+		return purl
+	}
+
+	if strings.HasPrefix(ref, "urn:cdx:") {
+		if _, fragment, found := strings.Cut(ref, "#"); found {
+			ref = fragment
+			if purl, ok := purlsByRef[ref]; ok {
+				return purl
+			}
+		}
+	}
+
+	// it is common practice to use the package URL as the BOM reference
+	if strings.HasPrefix(ref, "pkg:") {
+		return ref
+	}
+
+	return ""
+}
+
+// FilterMatches takes a set of scanning results and moves any results marked in
+// the VEX data as resolved or not_affected to the ignored list.
+func (p *Processor) FilterMatches(
+	docRaw interface{}, ignoreRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, _ *pkg.Context, matches *match.Matches, ignoredMatches []match.IgnoredMatch,
+) (*match.Matches, []match.IgnoredMatch, error) {
+	docs, ok := docRaw.(*internal.Documents)
+	if !ok {
+		return nil, nil, errors.New("unable to cast vex document as cyclonedx")
+	}
+
+	remainingMatches, ignoredMatches := internal.FilterMatches(docs, ignoreRules, metadataProvider, match.CycloneDXVexMatcher, matches, ignoredMatches)
+	return remainingMatches, ignoredMatches, nil
+}
+
+// AugmentMatches adds results to the match.Matches array when matching data
+// about an exploitable or in_triage package is found on loaded VEX documents.
+func (p *Processor) AugmentMatches(
+	docRaw interface{}, ignoreRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, _ *pkg.Context, remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch,
+) (*match.Matches, []match.IgnoredMatch, error) {
+	docs, ok := docRaw.(*internal.Documents)
+	if !ok {
+		return nil, nil, errors.New("unable to cast vex document as cyclonedx")
+	}
+
+	remainingMatches, ignoredMatches = internal.AugmentMatches(docs, ignoreRules, metadataProvider, match.CycloneDXVexMatcher, remainingMatches, ignoredMatches)
+	return remainingMatches, ignoredMatches, nil
+}
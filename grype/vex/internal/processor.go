@@ -0,0 +1,141 @@
+package internal
+
+import (
+	openvex "github.com/openvex/go-vex/pkg/vex"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+// augmentStatuses are the VEX statuses that augment results
+var augmentStatuses = []openvex.Status{
+	openvex.StatusAffected,
+	openvex.StatusUnderInvestigation,
+}
+
+// ignoreStatuses are the VEX statuses that filter matches to the ignore list
+var ignoreStatuses = []openvex.Status{
+	openvex.StatusNotAffected,
+	openvex.StatusFixed,
+}
+
+// FilterMatches takes a set of scanning results and moves any results marked in the VEX statements as fixed or
+// not_affected (and that have a corresponding VEX ignore rule) to the ignored list. The statement is kept as a detail
+// of the ignored match. The metadata provider is used to evaluate the severity and CVSS conditions of the rules.
+func FilterMatches(docs *Documents, ignoreRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, matcherType match.MatcherType, matches *match.Matches, ignoredMatches []match.IgnoredMatch) (*match.Matches, []match.IgnoredMatch) {
+	remainingMatches := match.NewMatches()
+
+	sorted := matches.Sorted()
+	rules := NewIgnoreRules(ignoreRules, metadataProvider, sorted...)
+	for i := range sorted {
+		statement := docs.Find(sorted[i])
+
+		// No data about this match's component. Next.
+		if statement == nil {
+			remainingMatches.Add(sorted[i])
+			continue
+		}
+
+		rule := matchingRule(rules.ApplicableTo(sorted[i]), statement, ignoreStatuses)
+		if rule == nil {
+			remainingMatches.Add(sorted[i])
+			continue
+		}
+
+		ignoredMatches = append(ignoredMatches, match.IgnoredMatch{
+			Match:              withStatementDetail(sorted[i], statement, matcherType),
+			AppliedIgnoreRules: []match.IgnoreRule{*rule},
+		})
+	}
+	return &remainingMatches, ignoredMatches
+}
+
+// AugmentMatches moves matches from the ignore list back to the results when the VEX statements mark the package
+// as affected (or under investigation) and there is a corresponding VEX ignore rule.
+func AugmentMatches(docs *Documents, ignoreRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, matcherType match.MatcherType, remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch) (*match.Matches, []match.IgnoredMatch) {
+	additionalIgnoredMatches := []match.IgnoredMatch{}
+
+	candidates := make([]match.Match, 0, len(ignoredMatches))
+	for _, ignored := range ignoredMatches {
+		candidates = append(candidates, ignored.Match)
+	}
+	rules := NewIgnoreRules(ignoreRules, metadataProvider, candidates...)
+
+	for i := range ignoredMatches {
+		statement := docs.Find(ignoredMatches[i].Match)
+
+		if statement == nil || (statement.Status != openvex.StatusAffected && statement.Status != openvex.StatusUnderInvestigation) {
+			additionalIgnoredMatches = append(additionalIgnoredMatches, ignoredMatches[i])
+			continue
+		}
+
+		// Only match if rules to augment are configured
+		rule := matchingRule(rules.ApplicableTo(ignoredMatches[i].Match), statement, augmentStatuses)
+		if rule == nil {
+			additionalIgnoredMatches = append(additionalIgnoredMatches, ignoredMatches[i])
+			continue
+		}
+
+		remainingMatches.Add(withStatementDetail(ignoredMatches[i].Match, statement, matcherType))
+	}
+
+	return remainingMatches, additionalIgnoredMatches
+}
+
+// withStatementDetail returns a copy of the match with a detail describing the VEX statement that applies to it.
+func withStatementDetail(m match.Match, statement *Statement, matcherType match.MatcherType) match.Match {
+	m.Details = append(m.Details, match.Detail{
+		Type: match.ExactDirectMatch,
+		SearchedBy: SearchedBy{
+			Vulnerability: m.Vulnerability.ID,
+			Package:       m.Package.PURL,
+		},
+		Found: Match{
+			Statement: *statement,
+		},
+		Matcher: matcherType,
+	})
+	return m
+}
+
+// matchingRule cycles through the ignore rules that apply to a match and returns the first one that matches the
+// statement. Returns nil if none match.
+func matchingRule(ignoreRules []match.IgnoreRule, statement *Statement, allowedStatuses []openvex.Status) *match.IgnoreRule {
+	revStatuses := map[string]struct{}{}
+	for _, s := range allowedStatuses {
+		revStatuses[string(s)] = struct{}{}
+	}
+
+	for _, rule := range ignoreRules {
+		// If the status in the statement is not the same in the rule
+		// and the vex statement, it does not apply
+		if string(statement.Status) != rule.VexStatus {
+			continue
+		}
+
+		// If the rule has a statement other than the allowed ones, skip:
+		if _, ok := revStatuses[rule.VexStatus]; !ok {
+			continue
+		}
+
+		// If the rule applies to a VEX justification it needs to match the
+		// statement, note that justifications only apply to not_affected:
+		if statement.Status == openvex.StatusNotAffected && rule.VexJustification != "" &&
+			rule.VexJustification != string(statement.Justification) {
+			continue
+		}
+
+		// If the vulnerability is blank in the rule it means we will honor
+		// any status with any vulnerability.
+		if rule.Vulnerability == "" {
+			return &rule
+		}
+
+		// If the vulnerability is set, the rule applies if it is the same
+		// in the statement and the rule.
+		if statement.MatchesVulnerability(rule.Vulnerability) {
+			return &rule
+		}
+	}
+	return nil
+}
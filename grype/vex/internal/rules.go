@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+// IgnoreRules are VEX ignore rules along with the matches that the conditions of each rule apply to. The VEX status
+// and justification of a rule are checked against the VEX statements instead, and expired rules are separated out by
+// the VEX processor beforehand, so these are not conditions here.
+type IgnoreRules struct {
+	rules []match.IgnoreRule
+	// applies holds the fingerprints of the matches each rule applies to, which is nil when the rule has no
+	// conditions (and so applies to any match)
+	applies []map[match.Fingerprint]struct{}
+}
+
+// NewIgnoreRules evaluates the conditions of the VEX ignore rules against the given matches. Each rule is parsed once
+// for all matches, and the metadata provider is used for the severity and CVSS conditions.
+func NewIgnoreRules(rules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, matches ...match.Match) IgnoreRules {
+	ms := match.NewMatches(matches...)
+
+	ir := IgnoreRules{
+		rules:   rules,
+		applies: make([]map[match.Fingerprint]struct{}, len(rules)),
+	}
+	for i, rule := range rules {
+		conditions := ruleConditions(rule)
+		if !conditions.HasConditions() {
+			continue
+		}
+
+		// the conditions never expire, so the time the rule is evaluated at does not matter
+		_, ignored := match.ApplyIgnoreRulesWithMetadata(ms, []match.IgnoreRule{conditions}, metadataProvider, time.Time{})
+		applies := make(map[match.Fingerprint]struct{}, len(ignored))
+		for _, m := range ignored {
+			applies[m.Fingerprint()] = struct{}{}
+		}
+		ir.applies[i] = applies
+	}
+	return ir
+}
+
+// ApplicableTo returns the rules with conditions that apply to the given match (in order), which must be one of the
+// matches the rules were evaluated against.
+func (ir IgnoreRules) ApplicableTo(m match.Match) []match.IgnoreRule {
+	var rules []match.IgnoreRule
+	for i, rule := range ir.rules {
+		if ir.applies[i] != nil {
+			if _, ok := ir.applies[i][m.Fingerprint()]; !ok {
+				continue
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// ruleConditions returns the part of a VEX ignore rule that is evaluated against matches, without the VEX status
+// and justification, the expiration and the "vex" namespace that identifies VEX rules.
+func ruleConditions(rule match.IgnoreRule) match.IgnoreRule {
+	rule.VexStatus = ""
+	rule.VexJustification = ""
+	rule.Expires = ""
+	if rule.Namespace == "vex" {
+		rule.Namespace = ""
+	}
+	return rule
+}
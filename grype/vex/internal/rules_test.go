@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/vulnerability"
+)
+
+type stubMetadataProvider map[string]vulnerability.Metadata
+
+func (p stubMetadataProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	m, ok := p[ref.ID]
+	if !ok {
+		return nil, nil
+	}
+	return &m, nil
+}
+
+func TestIgnoreRules_ApplicableTo(t *testing.T) {
+	newMatch := func(id, pkgName string) match.Match {
+		return match.Match{
+			Vulnerability: vulnerability.Vulnerability{Reference: vulnerability.Reference{ID: id, Namespace: "nvd:cpe"}},
+			Package:       pkg.Package{ID: pkg.ID(pkgName), Name: pkgName, Version: "1.0"},
+		}
+	}
+	critical := newMatch("CVE-2023-0001", "libcrypto3")
+	low := newMatch("CVE-2023-0002", "libcrypto3")
+	otherPkg := newMatch("CVE-2023-0001", "libssl3")
+
+	anyRule := match.IgnoreRule{Namespace: "vex", VexStatus: "fixed"}
+	severityRule := match.IgnoreRule{Namespace: "vex", VexStatus: "fixed", Severity: match.IgnoreRuleRange{Min: "high"}}
+	packageRule := match.IgnoreRule{Namespace: "vex", VexStatus: "not_affected", VexJustification: "component_not_present", Package: match.IgnoreRulePackage{Name: "libssl3"}}
+
+	rules := NewIgnoreRules(
+		[]match.IgnoreRule{anyRule, severityRule, packageRule},
+		stubMetadataProvider{
+			"CVE-2023-0001": {Severity: "Critical"},
+			"CVE-2023-0002": {Severity: "Low"},
+		},
+		critical, low, otherPkg,
+	)
+
+	assert.Equal(t, []match.IgnoreRule{anyRule, severityRule}, rules.ApplicableTo(critical))
+	assert.Equal(t, []match.IgnoreRule{anyRule}, rules.ApplicableTo(low))
+	assert.Equal(t, []match.IgnoreRule{anyRule, severityRule, packageRule}, rules.ApplicableTo(otherPkg))
+}
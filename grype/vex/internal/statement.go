@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"strings"
+
+	openvex "github.com/openvex/go-vex/pkg/vex"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/packageurl-go"
+)
+
+// Statement is a format-agnostic VEX assertion about a single vulnerability for a set of packages. Statuses and
+// justifications are always expressed in the OpenVEX vocabulary, since this is what user ignore rules are written
+// against (e.g. "vex-status: not_affected").
+type Statement struct {
+	// Vulnerability is the primary identifier of the vulnerability the statement is about
+	Vulnerability string `json:"vulnerability"`
+
+	// Aliases are other identifiers for the same vulnerability
+	Aliases []string `json:"aliases,omitempty"`
+
+	// Products are the package URLs of the packages the statement applies to
+	Products []string `json:"products"`
+
+	// Status is the impact status of the vulnerability on the products
+	Status openvex.Status `json:"status"`
+
+	// Justification explains why the products are not affected (only applies to the not_affected status)
+	Justification openvex.Justification `json:"justification,omitempty"`
+
+	// Detail is any free-form impact or action statement from the document
+	Detail string `json:"detail,omitempty"`
+
+	// Document is the path of the VEX document the statement was read from
+	Document string `json:"document"`
+}
+
+// Documents is the set of statements read from one or more VEX documents of the same format. Statements are kept
+// in document order, where later statements take precedence over earlier ones.
+type Documents struct {
+	Statements []Statement
+}
+
+// Match captures the VEX statement that caused a vulnerability to match
+type Match struct {
+	Statement Statement
+}
+
+// SearchedBy captures the parameters used to search through the VEX data
+type SearchedBy struct {
+	Vulnerability string
+	Package       string
+}
+
+// MatchesVulnerability returns true if any of the given vulnerability IDs refer to the statement vulnerability.
+func (s Statement) MatchesVulnerability(ids ...string) bool {
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if strings.EqualFold(s.Vulnerability, id) {
+			return true
+		}
+		for _, alias := range s.Aliases {
+			if strings.EqualFold(alias, id) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchesPackage returns true if the statement applies to the package with the given package URL.
+func (s Statement) MatchesPackage(purl string) bool {
+	for _, product := range s.Products {
+		if PackageURLMatches(product, purl) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the statement with the highest precedence that applies to the given match, or nil if there is none.
+func (d *Documents) Find(m match.Match) *Statement {
+	if d == nil || m.Package.PURL == "" {
+		return nil
+	}
+
+	ids := []string{m.Vulnerability.ID}
+	for _, related := range m.Vulnerability.RelatedVulnerabilities {
+		ids = append(ids, related.ID)
+	}
+
+	for i := len(d.Statements) - 1; i >= 0; i-- {
+		s := d.Statements[i]
+		if s.MatchesVulnerability(ids...) && s.MatchesPackage(m.Package.PURL) {
+			return &s
+		}
+	}
+	return nil
+}
+
+// PackageURLMatches indicates if the package URL from a VEX statement refers to the given package URL. Any
+// component that is not specified in the statement (version or qualifiers) matches any value in the package.
+func PackageURLMatches(statementPURL, packagePURL string) bool {
+	if statementPURL == "" || packagePURL == "" {
+		return false
+	}
+
+	if statementPURL == packagePURL {
+		return true
+	}
+
+	want, err := packageurl.FromString(statementPURL)
+	if err != nil {
+		return false
+	}
+
+	got, err := packageurl.FromString(packagePURL)
+	if err != nil {
+		return false
+	}
+
+	if want.Type != got.Type || !strings.EqualFold(want.Namespace, got.Namespace) || want.Name != got.Name {
+		return false
+	}
+
+	if want.Version != "" && want.Version != got.Version {
+		return false
+	}
+
+	gotQualifiers := got.Qualifiers.Map()
+	for k, v := range want.Qualifiers.Map() {
+		if gotQualifiers[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
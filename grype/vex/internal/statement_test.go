@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackageURLMatches(t *testing.T) {
+	tests := []struct {
+		name          string
+		statementPURL string
+		packagePURL   string
+		want          bool
+	}{
+		{
+			name:          "exact match",
+			statementPURL: "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+			want:          true,
+		},
+		{
+			name:          "statement without qualifiers matches any qualifiers",
+			statementPURL: "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3?arch=x86_64&upstream=openssl&distro=alpine-3.17.3",
+			want:          true,
+		},
+		{
+			name:          "statement without version matches any version",
+			statementPURL: "pkg:apk/alpine/libcrypto3",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+			want:          true,
+		},
+		{
+			name:          "different version",
+			statementPURL: "pkg:apk/alpine/libcrypto3@3.0.8-r4",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+		},
+		{
+			name:          "different name",
+			statementPURL: "pkg:apk/alpine/libssl3@3.0.8-r3",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+		},
+		{
+			name:          "statement qualifier must be present in the package",
+			statementPURL: "pkg:apk/alpine/libcrypto3@3.0.8-r3?arch=aarch64",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3?arch=x86_64",
+		},
+		{
+			name:          "invalid package url",
+			statementPURL: "libcrypto3",
+			packagePURL:   "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+		},
+		{
+			name:        "empty statement package url",
+			packagePURL: "pkg:apk/alpine/libcrypto3@3.0.8-r3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, PackageURLMatches(tt.statementPURL, tt.packagePURL))
+		})
+	}
+}
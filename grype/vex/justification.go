@@ -0,0 +1,36 @@
+package vex
+
+import (
+	gopenvex "github.com/openvex/go-vex/pkg/vex"
+
+	"github.com/anchore/grype/grype/match"
+	"github.com/anchore/grype/grype/vex/internal"
+	"github.com/anchore/grype/grype/vex/openvex"
+)
+
+// Justification returns the not_affected justification from the VEX statement recorded in the match details by the
+// VEX processor (in the OpenVEX vocabulary, regardless of the source document format). Returns false if the match
+// has no VEX statement with a justification.
+func Justification(m match.Match) (gopenvex.Justification, bool) {
+	for i := len(m.Details) - 1; i >= 0; i-- {
+		var statusJustification gopenvex.Justification
+		switch found := m.Details[i].Found.(type) {
+		case openvex.Match:
+			if found.Statement.Status != gopenvex.StatusNotAffected {
+				continue
+			}
+			statusJustification = found.Statement.Justification
+		case internal.Match:
+			if found.Statement.Status != gopenvex.StatusNotAffected {
+				continue
+			}
+			statusJustification = found.Statement.Justification
+		default:
+			continue
+		}
+		if statusJustification.Valid() {
+			return statusJustification, true
+		}
+	}
+	return "", false
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/vex/internal"
+	"github.com/anchore/grype/grype/vulnerability"
 	"github.com/anchore/packageurl-go"
 	"github.com/anchore/syft/syft/source"
 )
@@ -155,7 +157,7 @@ func subcomponentIdentifiersFromMatch(m *match.Match) []string {
 // FilterMatches takes a set of scanning results and moves any results marked in
 // the VEX data as fixed or not_affected to the ignored list.
 func (ovm *Processor) FilterMatches(
-	docRaw interface{}, ignoreRules []match.IgnoreRule, pkgContext *pkg.Context, matches *match.Matches, ignoredMatches []match.IgnoredMatch,
+	docRaw interface{}, ignoreRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, pkgContext *pkg.Context, matches *match.Matches, ignoredMatches []match.IgnoredMatch,
 ) (*match.Matches, []match.IgnoredMatch, error) {
 	doc, ok := docRaw.(*openvex.VEX)
 	if !ok {
@@ -174,14 +176,21 @@ func (ovm *Processor) FilterMatches(
 
 	// Now, let's go through grype's matches
 	sorted := matches.Sorted()
+	rules := internal.NewIgnoreRules(ignoreRules, metadataProvider, sorted...)
 	for i := range sorted {
 		var statement *openvex.Statement
+		var searchedBy *SearchedBy
 		subcmp := subcomponentIdentifiersFromMatch(&sorted[i])
 
 		// Range through the product's different names
 		for _, product := range products {
 			if matchingStatements := doc.Matches(sorted[i].Vulnerability.ID, product, subcmp); len(matchingStatements) != 0 {
 				statement = &matchingStatements[0]
+				searchedBy = &SearchedBy{
+					Vulnerability: sorted[i].Vulnerability.ID,
+					Product:       product,
+					Subcomponents: subcmp,
+				}
 				break
 			}
 		}
@@ -192,7 +201,7 @@ func (ovm *Processor) FilterMatches(
 			continue
 		}
 
-		rule := matchingRule(ignoreRules, sorted[i], statement, ignoreStatuses)
+		rule := matchingRule(rules.ApplicableTo(sorted[i]), statement, ignoreStatuses)
 		if rule == nil {
 			remainingMatches.Add(sorted[i])
 			continue
@@ -204,36 +213,34 @@ func (ovm *Processor) FilterMatches(
 			continue
 		}
 
+		// keep the statement with the ignored match so that its justification can be reported
+		ignoredMatch := sorted[i]
+		ignoredMatch.Details = append(ignoredMatch.Details, match.Detail{
+			Type:       match.ExactDirectMatch,
+			SearchedBy: searchedBy,
+			Found: Match{
+				Statement: *statement,
+			},
+			Matcher: match.OpenVexMatcher,
+		})
+
 		ignoredMatches = append(ignoredMatches, match.IgnoredMatch{
-			Match:              sorted[i],
+			Match:              ignoredMatch,
 			AppliedIgnoreRules: []match.IgnoreRule{*rule},
 		})
 	}
 	return &remainingMatches, ignoredMatches, nil
 }
 
-// matchingRule cycles through a set of ignore rules and returns the first
-// one that matches the statement and the match. Returns nil if none match.
-func matchingRule(ignoreRules []match.IgnoreRule, m match.Match, statement *openvex.Statement, allowedStatuses []openvex.Status) *match.IgnoreRule {
-	ms := match.NewMatches()
-	ms.Add(m)
-
+// matchingRule cycles through the ignore rules that apply to a match and
+// returns the first one that matches the statement. Returns nil if none match.
+func matchingRule(ignoreRules []match.IgnoreRule, statement *openvex.Statement, allowedStatuses []openvex.Status) *match.IgnoreRule {
 	revStatuses := map[string]struct{}{}
 	for _, s := range allowedStatuses {
 		revStatuses[string(s)] = struct{}{}
 	}
 
 	for _, rule := range ignoreRules {
-		// If the rule has more conditions than just the VEX statement, check if
-		// it applies to the current match.
-		if rule.HasConditions() {
-			r := rule
-			r.VexStatus = ""
-			if _, ignored := match.ApplyIgnoreRules(ms, []match.IgnoreRule{r}); len(ignored) == 0 {
-				continue
-			}
-		}
-
 		// If the status in the statement is not the same in the rule
 		// and the vex statement, it does not apply
 		if string(statement.Status) != rule.VexStatus {
@@ -273,7 +280,7 @@ func matchingRule(ignoreRules []match.IgnoreRule, m match.Match, statement *open
 // about an affected VEX product is found on loaded VEX documents. Matches
 // are moved from the ignore list or synthesized when no previous data is found.
 func (ovm *Processor) AugmentMatches(
-	docRaw interface{}, ignoreRules []match.IgnoreRule, pkgContext *pkg.Context, remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch,
+	docRaw interface{}, ignoreRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, pkgContext *pkg.Context, remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch,
 ) (*match.Matches, []match.IgnoredMatch, error) {
 	doc, ok := docRaw.(*openvex.VEX)
 	if !ok {
@@ -287,6 +294,12 @@ func (ovm *Processor) AugmentMatches(
 		return nil, nil, fmt.Errorf("reading product identifiers from context: %w", err)
 	}
 
+	candidates := make([]match.Match, 0, len(ignoredMatches))
+	for _, ignored := range ignoredMatches {
+		candidates = append(candidates, ignored.Match)
+	}
+	rules := internal.NewIgnoreRules(ignoreRules, metadataProvider, candidates...)
+
 	// Now, let's go through grype's matches
 	for i := range ignoredMatches {
 		var statement *openvex.Statement
@@ -318,7 +331,7 @@ func (ovm *Processor) AugmentMatches(
 		}
 
 		// Only match if rules to augment are configured
-		rule := matchingRule(ignoreRules, ignoredMatches[i].Match, statement, augmentStatuses)
+		rule := matchingRule(rules.ApplicableTo(ignoredMatches[i].Match), statement, augmentStatuses)
 		if rule == nil {
 			additionalIgnoredMatches = append(additionalIgnoredMatches, ignoredMatches[i])
 			continue
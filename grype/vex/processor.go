@@ -1,13 +1,22 @@
 package vex
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	gopenvex "github.com/openvex/go-vex/pkg/vex"
 
 	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/vex/csaf"
+	"github.com/anchore/grype/grype/vex/cyclonedx"
 	"github.com/anchore/grype/grype/vex/openvex"
+	"github.com/anchore/grype/grype/vulnerability"
 )
 
 type Status string
@@ -21,7 +30,6 @@ const (
 
 type Processor struct {
 	Options ProcessorOptions
-	impl    vexProcessorImplementation
 }
 
 type vexProcessorImplementation interface {
@@ -33,26 +41,50 @@ type vexProcessorImplementation interface {
 	// FilterMatches matches receives the underlying VEX implementation VEX data and
 	// the scanning context and matching results and filters the fixed and
 	// not_affected results,moving them to the list of ignored matches.
-	FilterMatches(interface{}, []match.IgnoreRule, *pkg.Context, *match.Matches, []match.IgnoredMatch) (*match.Matches, []match.IgnoredMatch, error)
+	FilterMatches(interface{}, []match.IgnoreRule, vulnerability.MetadataProvider, *pkg.Context, *match.Matches, []match.IgnoredMatch) (*match.Matches, []match.IgnoredMatch, error)
 
 	// AugmentMatches reads known affected VEX products from loaded documents and
 	// adds new results to the scanner results when the product is marked as
 	// affected in the VEX data.
-	AugmentMatches(interface{}, []match.IgnoreRule, *pkg.Context, *match.Matches, []match.IgnoredMatch) (*match.Matches, []match.IgnoredMatch, error)
+	AugmentMatches(interface{}, []match.IgnoreRule, vulnerability.MetadataProvider, *pkg.Context, *match.Matches, []match.IgnoredMatch) (*match.Matches, []match.IgnoredMatch, error)
 }
 
-// getVexImplementation this function returns the vex processor implementation
-// at some point it can read the options and choose a user configured implementation.
-func getVexImplementation() vexProcessorImplementation {
-	return openvex.New()
+// getVexImplementation returns the vex processor implementation for the given
+// document, based on the format detected from the file contents.
+func getVexImplementation(document string) (string, vexProcessorImplementation, error) {
+	contents, err := os.ReadFile(document)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading vex document: %w", err)
+	}
+
+	switch {
+	case isOpenVEX(contents):
+		return "openvex", openvex.New(), nil
+	case cyclonedx.IsCycloneDX(contents):
+		return "cyclonedx", cyclonedx.New(), nil
+	case csaf.IsCSAF(contents):
+		return "csaf", csaf.New(), nil
+	}
+	return "", nil, fmt.Errorf("unable to determine the VEX format of %q (supported formats: OpenVEX, CycloneDX, CSAF)", document)
 }
 
-// NewProcessor returns a new VEX processor. For now, it defaults to the only vex
-// implementation: OpenVEX
+// isOpenVEX indicates if the given document contents are an OpenVEX document.
+func isOpenVEX(contents []byte) bool {
+	var doc struct {
+		// the context may be a single locator or a list of them
+		Context json.RawMessage `json:"@context"`
+	}
+	if err := json.NewDecoder(bytes.NewReader(contents)).Decode(&doc); err != nil {
+		return false
+	}
+	return strings.Contains(string(doc.Context), gopenvex.Context)
+}
+
+// NewProcessor returns a new VEX processor. The implementation used for each
+// document (OpenVEX, CycloneDX or CSAF) is detected when the VEX data is applied.
 func NewProcessor(opts ProcessorOptions) *Processor {
 	return &Processor{
 		Options: opts,
-		impl:    getVexImplementation(),
 	}
 }
 
@@ -60,6 +92,8 @@ func NewProcessor(opts ProcessorOptions) *Processor {
 type ProcessorOptions struct {
 	Documents   []string
 	IgnoreRules []match.IgnoreRule
+	// MetadataProvider is used to evaluate the severity and CVSS conditions of the ignore rules (optional)
+	MetadataProvider vulnerability.MetadataProvider
 }
 
 // ApplyVEX receives the results from a scan run and applies any VEX information
@@ -73,40 +107,130 @@ func (vm *Processor) ApplyVEX(pkgContext *pkg.Context, remainingMatches *match.M
 		return remainingMatches, ignoredMatches, nil
 	}
 
-	// Read VEX data from all passed documents
-	rawVexData, err := vm.impl.ReadVexDocuments(vm.Options.Documents)
+	groups, err := groupDocuments(vm.Options.Documents)
 	if err != nil {
-		return nil, nil, fmt.Errorf("parsing vex document: %w", err)
+		return nil, nil, err
 	}
 
-	vexRules := extractVexRules(vm.Options.IgnoreRules)
+	// Read VEX data from all passed documents
+	for i := range groups {
+		groups[i].data, err = groups[i].impl.ReadVexDocuments(groups[i].documents)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing vex document: %w", err)
+		}
+	}
 
-	remainingMatches, ignoredMatches, err = vm.impl.FilterMatches(
-		rawVexData, vexRules, pkgContext, remainingMatches, ignoredMatches,
-	)
-	if err != nil {
-		return nil, nil, fmt.Errorf("checking matches against VEX data: %w", err)
+	vexRules, expiredRules := extractVexRules(vm.Options.IgnoreRules, time.Now())
+
+	// all documents are used to filter matches before any are used to augment, so that an affected status in
+	// one format may restore a match that was filtered by another
+	for _, g := range groups {
+		remainingMatches, ignoredMatches, err = g.impl.FilterMatches(
+			g.data, vexRules, vm.Options.MetadataProvider, pkgContext, remainingMatches, ignoredMatches,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("checking matches against VEX data: %w", err)
+		}
 	}
 
-	remainingMatches, ignoredMatches, err = vm.impl.AugmentMatches(
-		rawVexData, vexRules, pkgContext, remainingMatches, ignoredMatches,
-	)
+	remainingMatches, err = recordExpiredRules(groups, expiredRules, vm.Options.MetadataProvider, pkgContext, remainingMatches)
 	if err != nil {
-		return nil, nil, fmt.Errorf("checking matches to augment from VEX data: %w", err)
+		return nil, nil, fmt.Errorf("checking matches against expired VEX rules: %w", err)
+	}
+
+	for _, g := range groups {
+		remainingMatches, ignoredMatches, err = g.impl.AugmentMatches(
+			g.data, vexRules, vm.Options.MetadataProvider, pkgContext, remainingMatches, ignoredMatches,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("checking matches to augment from VEX data: %w", err)
+		}
 	}
 
 	return remainingMatches, ignoredMatches, nil
 }
 
+// documentGroup is a set of VEX documents of the same format along with the implementation that processes them
+type documentGroup struct {
+	format    string
+	impl      vexProcessorImplementation
+	documents []string
+	data      interface{}
+}
+
+// groupDocuments detects the format of every document and groups them by format, in order of first appearance.
+func groupDocuments(documents []string) ([]documentGroup, error) {
+	var groups []documentGroup
+	indexByFormat := make(map[string]int)
+	for _, doc := range documents {
+		format, impl, err := getVexImplementation(doc)
+		if err != nil {
+			return nil, err
+		}
+
+		idx, ok := indexByFormat[format]
+		if !ok {
+			idx = len(groups)
+			indexByFormat[format] = idx
+			groups = append(groups, documentGroup{format: format, impl: impl})
+		}
+		groups[idx].documents = append(groups[idx].documents, doc)
+	}
+	return groups, nil
+}
+
 // extractVexRules is a utility function that takes a set of ignore rules and
-// extracts those that act on VEX statuses.
-func extractVexRules(rules []match.IgnoreRule) []match.IgnoreRule {
+// extracts those that act on VEX statuses, separating the rules that have
+// expired as of the given time (which no longer apply).
+func extractVexRules(rules []match.IgnoreRule, at time.Time) ([]match.IgnoreRule, []match.IgnoreRule) {
 	newRules := []match.IgnoreRule{}
+	var expiredRules []match.IgnoreRule
 	for _, r := range rules {
-		if r.VexStatus != "" {
-			newRules = append(newRules, r)
-			newRules[len(newRules)-1].Namespace = "vex"
+		if r.VexStatus == "" {
+			continue
+		}
+		r.Namespace = "vex"
+		if r.IsExpired(at) {
+			expiredRules = append(expiredRules, r)
+			continue
+		}
+		newRules = append(newRules, r)
+	}
+	return newRules, expiredRules
+}
+
+// recordExpiredRules records the expired VEX rules that would have filtered the remaining matches on those
+// matches (see match.Match.ExpiredIgnoreRules), the matches themselves are not filtered.
+func recordExpiredRules(groups []documentGroup, expiredRules []match.IgnoreRule, metadataProvider vulnerability.MetadataProvider, pkgContext *pkg.Context, remainingMatches *match.Matches) (*match.Matches, error) {
+	if len(expiredRules) == 0 {
+		return remainingMatches, nil
+	}
+
+	// each rule is checked on its own, since only the first rule that applies is recorded when filtering
+	expiredByMatch := make(map[match.Fingerprint][]match.IgnoreRule)
+	for _, r := range expiredRules {
+		for _, g := range groups {
+			_, wouldIgnore, err := g.impl.FilterMatches(g.data, []match.IgnoreRule{r}, metadataProvider, pkgContext, remainingMatches, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, ignored := range wouldIgnore {
+				fp := ignored.Fingerprint()
+				if !slices.Contains(expiredByMatch[fp], r) {
+					expiredByMatch[fp] = append(expiredByMatch[fp], r)
+				}
+			}
+		}
+	}
+
+	out := match.NewMatches()
+	for _, m := range remainingMatches.Sorted() {
+		for _, r := range expiredByMatch[m.Fingerprint()] {
+			if !slices.Contains(m.ExpiredIgnoreRules, r) {
+				m.ExpiredIgnoreRules = append(m.ExpiredIgnoreRules, r)
+			}
 		}
+		out.Add(m)
 	}
-	return newRules
+	return &out, nil
 }
@@ -3,6 +3,7 @@ package vex
 import (
 	"testing"
 
+	gopenvex "github.com/openvex/go-vex/pkg/vex"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -97,6 +98,11 @@ func TestProcessor_ApplyVEX(t *testing.T) {
 		return &s
 	}
 
+	withExpiredRules := func(m match.Match, rules ...match.IgnoreRule) match.Match {
+		m.ExpiredIgnoreRules = rules
+		return m
+	}
+
 	matchesRef := func(ms ...match.Match) *match.Matches {
 		m := match.NewMatches(ms...)
 		return &m
@@ -114,6 +120,8 @@ func TestProcessor_ApplyVEX(t *testing.T) {
 		args               args
 		wantMatches        *match.Matches
 		wantIgnoredMatches []match.IgnoredMatch
+		// wantJustifications are the justifications from the VEX statements recorded on the ignored matches
+		wantJustifications map[string]gopenvex.Justification
 		wantErr            require.ErrorAssertionFunc
 	}{
 		{
@@ -176,6 +184,135 @@ func TestProcessor_ApplyVEX(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "openvex-demo1 - ignore by fixed status and severity",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/openvex-demo1.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus: "fixed",
+						Severity:  match.IgnoreRuleRange{Min: "high"},
+					},
+				},
+				MetadataProvider: stubMetadataProvider{
+					"CVE-2023-1255": {Severity: "Critical"},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_3817, libCryptoCVE_2023_2975),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_1255,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace: "vex",
+							VexStatus: "fixed",
+							Severity:  match.IgnoreRuleRange{Min: "high"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "openvex-demo1 - keep fixed status below the severity of the rule",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/openvex-demo1.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus: "fixed",
+						Severity:  match.IgnoreRuleRange{Min: "high"},
+					},
+				},
+				MetadataProvider: stubMetadataProvider{
+					"CVE-2023-1255": {Severity: "Low"},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches:        matchesRef(libCryptoCVE_2023_3817, libCryptoCVE_2023_2975, libCryptoCVE_2023_1255),
+			wantIgnoredMatches: []match.IgnoredMatch{},
+		},
+		{
+			name: "openvex-demo1 - expired rule does not ignore by fixed status",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/openvex-demo1.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus: "fixed",
+						Expires:   "2020-01-01",
+					},
+					{
+						Vulnerability: "CVE-2023-1255",
+						VexStatus:     "fixed",
+						Expires:       "2020-01-01T00:00:00Z",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(
+				libCryptoCVE_2023_3817,
+				libCryptoCVE_2023_2975,
+				withExpiredRules(libCryptoCVE_2023_1255,
+					match.IgnoreRule{Namespace: "vex", VexStatus: "fixed", Expires: "2020-01-01"},
+					match.IgnoreRule{Namespace: "vex", Vulnerability: "CVE-2023-1255", VexStatus: "fixed", Expires: "2020-01-01T00:00:00Z"},
+				),
+			),
+			wantIgnoredMatches: []match.IgnoredMatch{},
+		},
+		{
+			name: "csaf-demo - expired rule does not ignore by fixed status and CVE",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/csaf-demo.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						Vulnerability: "CVE-2023-2975",
+						VexStatus:     "fixed",
+						Expires:       "2020-01-01",
+					},
+					{
+						Vulnerability: "CVE-2023-2975",
+						VexStatus:     "fixed",
+						Expires:       "2999-12-31",
+						Reason:        "renewed",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_3817, libCryptoCVE_2023_1255),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_2975,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace:     "vex",
+							Vulnerability: "CVE-2023-2975",
+							VexStatus:     "fixed",
+							Expires:       "2999-12-31",
+							Reason:        "renewed",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "openvex-demo2 - ignore by fixed status",
 			options: ProcessorOptions{
@@ -296,6 +433,204 @@ func TestProcessor_ApplyVEX(t *testing.T) {
 					},
 				},
 			},
+			wantJustifications: map[string]gopenvex.Justification{
+				"CVE-2023-3817": gopenvex.VulnerableCodeNotPresent,
+			},
+		},
+		{
+			name: "cyclonedx-demo - ignore by fixed status",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/cyclonedx-demo.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus: "fixed",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_3817),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_1255,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace: "vex",
+							VexStatus: "fixed",
+						},
+					},
+				},
+				{
+					Match: libCryptoCVE_2023_2975,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace: "vex",
+							VexStatus: "fixed",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "cyclonedx-demo - ignore by not_affected status and vulnerable_code_not_present justification",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/cyclonedx-demo.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus:        "not_affected",
+						VexJustification: "vulnerable_code_not_present",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_2975, libCryptoCVE_2023_1255),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_3817,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace:        "vex",
+							VexStatus:        "not_affected",
+							VexJustification: "vulnerable_code_not_present",
+						},
+					},
+				},
+			},
+			wantJustifications: map[string]gopenvex.Justification{
+				"CVE-2023-3817": gopenvex.VulnerableCodeNotPresent,
+			},
+		},
+		{
+			name: "csaf-demo - ignore by fixed status",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/csaf-demo.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus: "fixed",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_3817, libCryptoCVE_2023_1255),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_2975,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace: "vex",
+							VexStatus: "fixed",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "csaf-demo - ignore by not_affected status and vulnerable_code_not_present justification",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/csaf-demo.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						VexStatus:        "not_affected",
+						VexJustification: "vulnerable_code_not_present",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_2975, libCryptoCVE_2023_1255),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_3817,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace:        "vex",
+							VexStatus:        "not_affected",
+							VexJustification: "vulnerable_code_not_present",
+						},
+					},
+				},
+			},
+			wantJustifications: map[string]gopenvex.Justification{
+				"CVE-2023-3817": gopenvex.VulnerableCodeNotPresent,
+			},
+		},
+		{
+			name: "mixed openvex and csaf documents",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/openvex-demo1.json",
+					"testdata/vex-docs/csaf-demo.json",
+				},
+				IgnoreRules: []match.IgnoreRule{
+					{
+						Vulnerability: "CVE-2023-1255",
+						VexStatus:     "fixed",
+					},
+					{
+						VexStatus: "not_affected",
+					},
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantMatches: matchesRef(libCryptoCVE_2023_2975),
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: libCryptoCVE_2023_1255,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace:     "vex",
+							Vulnerability: "CVE-2023-1255",
+							VexStatus:     "fixed",
+						},
+					},
+				},
+				{
+					Match: libCryptoCVE_2023_3817,
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Namespace: "vex",
+							VexStatus: "not_affected",
+						},
+					},
+				},
+			},
+			wantJustifications: map[string]gopenvex.Justification{
+				"CVE-2023-3817": gopenvex.VulnerableCodeNotPresent,
+			},
+		},
+		{
+			name: "unknown document format",
+			options: ProcessorOptions{
+				Documents: []string{
+					"testdata/vex-docs/not-vex.json",
+				},
+			},
+			args: args{
+				pkgContext: pkgContext,
+				matches:    getSubject(),
+			},
+			wantErr: require.Error,
 		},
 	}
 	for _, tt := range tests {
@@ -312,8 +647,87 @@ func TestProcessor_ApplyVEX(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.wantMatches.Sorted(), actualMatches.Sorted())
+
+			// the VEX statements are recorded as details of the ignored matches
+			actualJustifications := map[string]gopenvex.Justification{}
+			for i := range actualIgnoredMatches {
+				if j, ok := Justification(actualIgnoredMatches[i].Match); ok {
+					actualJustifications[actualIgnoredMatches[i].Vulnerability.ID] = j
+				}
+				actualIgnoredMatches[i].Details = withoutStatementDetails(actualIgnoredMatches[i].Details)
+			}
 			assert.Equal(t, tt.wantIgnoredMatches, actualIgnoredMatches)
+			if tt.wantJustifications == nil {
+				tt.wantJustifications = map[string]gopenvex.Justification{}
+			}
+			assert.Equal(t, tt.wantJustifications, actualJustifications)
+
+		})
+	}
+}
+
+type stubMetadataProvider map[string]vulnerability.Metadata
+
+func (p stubMetadataProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	m, ok := p[ref.ID]
+	if !ok {
+		return nil, nil
+	}
+	return &m, nil
+}
+
+func withoutStatementDetails(details match.Details) match.Details {
+	var out match.Details
+	for _, d := range details {
+		switch d.Matcher {
+		case match.OpenVexMatcher, match.CycloneDXVexMatcher, match.CSAFVexMatcher:
+			continue
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+func Test_getVexImplementation(t *testing.T) {
+	tests := []struct {
+		document   string
+		wantFormat string
+		wantErr    require.ErrorAssertionFunc
+	}{
+		{
+			document:   "testdata/vex-docs/openvex-demo1.json",
+			wantFormat: "openvex",
+		},
+		{
+			document:   "testdata/vex-docs/cyclonedx-demo.json",
+			wantFormat: "cyclonedx",
+		},
+		{
+			document:   "testdata/vex-docs/csaf-demo.json",
+			wantFormat: "csaf",
+		},
+		{
+			document: "testdata/vex-docs/not-vex.json",
+			wantErr:  require.Error,
+		},
+		{
+			document: "testdata/vex-docs/does-not-exist.json",
+			wantErr:  require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.document, func(t *testing.T) {
+			if tt.wantErr == nil {
+				tt.wantErr = require.NoError
+			}
 
+			format, impl, err := getVexImplementation(tt.document)
+			tt.wantErr(t, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantFormat, format)
+			assert.NotNil(t, impl)
 		})
 	}
 }
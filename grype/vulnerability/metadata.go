@@ -1,13 +1,17 @@
 package vulnerability
 
+import "time"
+
 type Metadata struct {
-	ID          string
-	DataSource  string
-	Namespace   string
-	Severity    string
-	URLs        []string
-	Description string
-	Cvss        []Cvss
+	ID             string
+	DataSource     string
+	Namespace      string
+	Severity       string
+	URLs           []string
+	Description    string
+	Cvss           []Cvss
+	EPSS           []EPSS
+	KnownExploited []KnownExploited
 }
 
 type Cvss struct {
@@ -19,6 +23,28 @@ type Cvss struct {
 	VendorMetadata interface{}
 }
 
+// EPSS is the Exploit Prediction Scoring System data for a single CVE (see https://www.first.org/epss).
+type EPSS struct {
+	CVE        string
+	EPSS       float64   // probability of exploitation activity in the next 30 days (0-1)
+	Percentile float64   // proportion of all scored vulnerabilities with the same or a lower EPSS score (0-1)
+	Date       time.Time // date of the EPSS model run that produced the score
+}
+
+// KnownExploited is a single entry from the CISA Known Exploited Vulnerabilities (KEV) catalog
+// (see https://www.cisa.gov/known-exploited-vulnerabilities-catalog).
+type KnownExploited struct {
+	CVE                        string
+	VendorProject              string
+	Product                    string
+	DateAdded                  *time.Time // date the vulnerability was added to the catalog
+	RequiredAction             string
+	DueDate                    *time.Time // date the required action is due
+	KnownRansomwareCampaignUse string
+	Notes                      string
+	URLs                       []string
+}
+
 type CvssMetrics struct {
 	BaseScore           float64
 	ExploitabilityScore *float64
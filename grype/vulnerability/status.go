@@ -0,0 +1,28 @@
+package vulnerability
+
+// Status is the point in the lifecycle of a vulnerability record (e.g. whether the record has been rejected by the
+// issuing authority). An empty status is equivalent to StatusActive, as not all data sources track this.
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusAnalyzing Status = "analyzing"
+	StatusDisputed  Status = "disputed"
+	StatusRejected  Status = "rejected"
+	StatusWithdrawn Status = "withdrawn"
+)
+
+func AllStatuses() []Status {
+	return []Status{
+		StatusActive,
+		StatusAnalyzing,
+		StatusDisputed,
+		StatusRejected,
+		StatusWithdrawn,
+	}
+}
+
+// IsActive indicates if the record is actionable as-is (the status is either active or not known).
+func (s Status) IsActive() bool {
+	return s == "" || s == StatusActive
+}
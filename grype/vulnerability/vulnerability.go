@@ -22,6 +22,8 @@ type Vulnerability struct {
 	Fix                    Fix
 	Advisories             []Advisory
 	RelatedVulnerabilities []Reference
+	// Status is the lifecycle status of the vulnerability record (empty when the data source does not provide one)
+	Status Status
 }
 
 func (v Vulnerability) String() string {
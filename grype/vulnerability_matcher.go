@@ -1,13 +1,19 @@
 package grype
 
 import (
+	"errors"
 	"fmt"
 	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/scylladb/go-set/strset"
 	"github.com/wagoodman/go-partybus"
 	"github.com/wagoodman/go-progress"
 
+	"github.com/anchore/grype/grype/baseline"
 	v5 "github.com/anchore/grype/grype/db/v5"
 	"github.com/anchore/grype/grype/db/v5/matcher"
 	"github.com/anchore/grype/grype/db/v5/matcher/stock"
@@ -36,7 +42,28 @@ type VulnerabilityMatcher struct {
 	IgnoreRules    []match.IgnoreRule
 	FailSeverity   *vulnerability.Severity
 	NormalizeByCVE bool
-	VexProcessor   *vex.Processor
+	// NormalizeBy is an ordered preference of vulnerability ID prefixes (e.g. "ghsa", "cve") that matches are re-keyed
+	// onto when an alias with such an ID is known, taking precedence over NormalizeByCVE (which is the same as "cve")
+	NormalizeBy  []string
+	VexProcessor *vex.Processor
+	// FailEPSSPercentile is the EPSS percentile (0-1) at or above which any match results in ErrAboveEPSSThreshold
+	FailEPSSPercentile *float64
+	// FailOnKEV results in ErrKnownExploitedVulnerability when any match is in the CISA KEV catalog
+	FailOnKEV bool
+	// Parallelism is the maximum number of packages that are searched for matches concurrently (values less than 2
+	// result in packages being searched sequentially).
+	Parallelism int
+	// Baseline causes matches that are unchanged since a previous scan to be ignored, so that only new findings are
+	// reported and considered by the fail-on checks
+	Baseline *baseline.Baseline
+	// FlaggedStatuses are the vulnerability record statuses (e.g. disputed) which are called out on the matches for
+	// such records (see match.Match.FlaggedStatus)
+	FlaggedStatuses []vulnerability.Status
+	// Clock returns the time that ignore rule expiration is evaluated at (the current time when not set)
+	Clock func() time.Time
+
+	notEvaluated     []match.NotEvaluated
+	notEvaluatedLock sync.Mutex
 }
 
 func DefaultVulnerabilityMatcher(store v5.ProviderStore) *VulnerabilityMatcher {
@@ -51,6 +78,16 @@ func (m *VulnerabilityMatcher) FailAtOrAboveSeverity(severity *vulnerability.Sev
 	return m
 }
 
+func (m *VulnerabilityMatcher) FailAtOrAboveEPSSPercentile(percentile *float64) *VulnerabilityMatcher {
+	m.FailEPSSPercentile = percentile
+	return m
+}
+
+func (m *VulnerabilityMatcher) FailOnKnownExploited(fail bool) *VulnerabilityMatcher {
+	m.FailOnKEV = fail
+	return m
+}
+
 func (m *VulnerabilityMatcher) WithMatchers(matchers []matcher.Matcher) *VulnerabilityMatcher {
 	m.Matchers = matchers
 	return m
@@ -61,6 +98,21 @@ func (m *VulnerabilityMatcher) WithIgnoreRules(ignoreRules []match.IgnoreRule) *
 	return m
 }
 
+func (m *VulnerabilityMatcher) WithParallelism(parallelism int) *VulnerabilityMatcher {
+	m.Parallelism = parallelism
+	return m
+}
+
+func (m *VulnerabilityMatcher) WithBaseline(b *baseline.Baseline) *VulnerabilityMatcher {
+	m.Baseline = b
+	return m
+}
+
+func (m *VulnerabilityMatcher) WithFlaggedStatuses(statuses ...vulnerability.Status) *VulnerabilityMatcher {
+	m.FlaggedStatuses = statuses
+	return m
+}
+
 func (m *VulnerabilityMatcher) FindMatches(pkgs []pkg.Package, context pkg.Context) (remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch, err error) {
 	progressMonitor := trackMatcher(len(pkgs))
 
@@ -72,7 +124,15 @@ func (m *VulnerabilityMatcher) FindMatches(pkgs []pkg.Package, context pkg.Conte
 		}
 	}()
 
-	remainingMatches, ignoredMatches, err = m.findDBMatches(pkgs, context, progressMonitor)
+	// all ignore rules are evaluated at the same time, so that rules reported as expired are not applied
+	at := m.now()
+	warnExpiredIgnoreRules(m.IgnoreRules, at)
+
+	m.notEvaluatedLock.Lock()
+	m.notEvaluated = nil
+	m.notEvaluatedLock.Unlock()
+
+	remainingMatches, ignoredMatches, err = m.findDBMatches(pkgs, context, progressMonitor, at)
 	if err != nil {
 		return remainingMatches, ignoredMatches, err
 	}
@@ -83,11 +143,26 @@ func (m *VulnerabilityMatcher) FindMatches(pkgs []pkg.Package, context pkg.Conte
 		return remainingMatches, ignoredMatches, err
 	}
 
+	if m.Baseline != nil {
+		newMatches, allIgnoredMatches := m.Baseline.Apply(*remainingMatches, ignoredMatches)
+		remainingMatches, ignoredMatches = &newMatches, allIgnoredMatches
+	}
+
 	if m.FailSeverity != nil && HasSeverityAtOrAbove(m.Store, *m.FailSeverity, *remainingMatches) {
 		err = grypeerr.ErrAboveSeverityThreshold
 		return remainingMatches, ignoredMatches, err
 	}
 
+	if m.FailEPSSPercentile != nil && HasEPSSPercentileAtOrAbove(m.Store, *m.FailEPSSPercentile, *remainingMatches) {
+		err = grypeerr.ErrAboveEPSSThreshold
+		return remainingMatches, ignoredMatches, err
+	}
+
+	if m.FailOnKEV && HasKnownExploited(m.Store, *remainingMatches) {
+		err = grypeerr.ErrKnownExploitedVulnerability
+		return remainingMatches, ignoredMatches, err
+	}
+
 	logListSummary(progressMonitor)
 
 	logIgnoredMatches(ignoredMatches)
@@ -95,21 +170,34 @@ func (m *VulnerabilityMatcher) FindMatches(pkgs []pkg.Package, context pkg.Conte
 	return remainingMatches, ignoredMatches, nil
 }
 
-func (m *VulnerabilityMatcher) findDBMatches(pkgs []pkg.Package, context pkg.Context, progressMonitor *monitorWriter) (*match.Matches, []match.IgnoredMatch, error) {
+func (m *VulnerabilityMatcher) findDBMatches(pkgs []pkg.Package, context pkg.Context, progressMonitor *monitorWriter, at time.Time) (*match.Matches, []match.IgnoredMatch, error) {
 	var ignoredMatches []match.IgnoredMatch
 
 	log.Trace("finding matches against DB")
-	matches, err := m.searchDBForMatches(context.Distro, pkgs, progressMonitor)
+	matches, distroSuppressedMatches, err := m.searchDBForMatches(context.Distro, pkgs, progressMonitor)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to find matches in DB: %w", err)
 	}
 
-	matches, ignoredMatches = m.applyIgnoreRules(matches)
+	matches, ignoredMatches = m.applyIgnoreRules(matches, at)
+	ignoredMatches = append(distroSuppressedMatches, ignoredMatches...)
+
+	if preferences := m.normalizeBy(); len(preferences) > 0 {
+		aliases := newVulnerabilityAliases(matches, ignoredMatches)
+
+		// a rule that ignores an alias of the matched vulnerability (e.g. the CVE for a GHSA) should be honored
+		// regardless of which ID the match is ultimately reported under.
+		var aliasIgnoredMatches []match.IgnoredMatch
+		matches, aliasIgnoredMatches = m.applyIgnoreRulesToAliases(matches, aliases, at)
+		ignoredMatches = append(ignoredMatches, aliasIgnoredMatches...)
+
+		normalize := func(original match.Match) match.Match {
+			return m.normalize(original, preferences, aliases)
+		}
 
-	if m.NormalizeByCVE {
 		normalizedMatches := match.NewMatches()
 		for originalMatch := range matches.Enumerate() {
-			normalizedMatches.Add(m.normalizeByCVE(originalMatch))
+			normalizedMatches.Add(normalize(originalMatch))
 		}
 
 		// we apply the ignore rules again in case any of the transformations done during normalization
@@ -117,20 +205,44 @@ func (m *VulnerabilityMatcher) findDBMatches(pkgs []pkg.Package, context pkg.Con
 		// the ignore rules before normalizing? In case the user has a rule that ignores a non-normalized
 		// vulnerability ID, we wantMatches to ensure that the rule is honored.
 		originalIgnoredMatches := ignoredMatches
-		matches, ignoredMatches = m.applyIgnoreRules(normalizedMatches)
-		ignoredMatches = m.mergeIgnoredMatches(originalIgnoredMatches, ignoredMatches)
+		matches, ignoredMatches = m.applyIgnoreRules(normalizedMatches, at)
+		ignoredMatches = mergeIgnoredMatches(normalize, originalIgnoredMatches, ignoredMatches)
+	}
+
+	if len(m.FlaggedStatuses) > 0 {
+		matches, ignoredMatches = m.flagStatuses(matches, ignoredMatches)
 	}
 
 	return &matches, ignoredMatches, nil
 }
 
-func (m *VulnerabilityMatcher) mergeIgnoredMatches(allIgnoredMatches ...[]match.IgnoredMatch) []match.IgnoredMatch {
+// flagStatuses annotates the matches for vulnerability records with one of the flagged statuses, so that they are
+// called out when reported.
+func (m *VulnerabilityMatcher) flagStatuses(matches match.Matches, ignoredMatches []match.IgnoredMatch) (match.Matches, []match.IgnoredMatch) {
+	flag := func(mt match.Match) match.Match {
+		if slices.Contains(m.FlaggedStatuses, mt.Vulnerability.Status) {
+			mt.FlaggedStatus = mt.Vulnerability.Status
+		}
+		return mt
+	}
+
+	flaggedMatches := match.NewMatches()
+	for mt := range matches.Enumerate() {
+		flaggedMatches.Add(flag(mt))
+	}
+
+	for i := range ignoredMatches {
+		ignoredMatches[i].Match = flag(ignoredMatches[i].Match)
+	}
+
+	return flaggedMatches, ignoredMatches
+}
+
+func mergeIgnoredMatches(normalize func(match.Match) match.Match, allIgnoredMatches ...[]match.IgnoredMatch) []match.IgnoredMatch {
 	var out []match.IgnoredMatch
 	for _, ignoredMatches := range allIgnoredMatches {
 		for _, ignored := range ignoredMatches {
-			if m.NormalizeByCVE {
-				ignored.Match = m.normalizeByCVE(ignored.Match)
-			}
+			ignored.Match = normalize(ignored.Match)
 			out = append(out, ignored)
 		}
 	}
@@ -141,7 +253,7 @@ func (m *VulnerabilityMatcher) searchDBForMatches(
 	release *linux.Release,
 	packages []pkg.Package,
 	progressMonitor *monitorWriter,
-) (match.Matches, error) {
+) (match.Matches, []match.IgnoredMatch, error) {
 	var err error
 	res := match.NewMatches()
 	matcherIndex, defaultMatcher := newMatcherIndex(m.Matchers)
@@ -154,105 +266,219 @@ func (m *VulnerabilityMatcher) searchDBForMatches(
 		}
 		if d != nil && d.Disabled() {
 			log.Warnf("unsupported linux distribution: %s", d.Name())
-			return match.NewMatches(), nil
+			return match.NewMatches(), nil, nil
 		}
 	}
 
-	distroFalsePositivesByLocationPath := make(map[string][]string)
-	if d != nil && (d.Type == distro.Wolfi || d.Type == distro.Chainguard || d.Type == distro.Alpine) {
-		distroFalsePositivesByLocationPath, err = indexFalsePositivesByLocation(d, packages, m.Store)
+	distroFalsePositivesByLocationPath := make(map[string][]distroFalsePositive)
+	if d != nil {
+		distroFalsePositivesByLocationPath, err = indexFalsePositivesByLocation(d, distroFalsePositiveCandidates(packages), m.Store)
 		if err != nil {
-			return match.Matches{}, err
+			return match.Matches{}, nil, err
 		}
 	}
 
 	if defaultMatcher == nil {
 		defaultMatcher = stock.NewStockMatcher(stock.MatcherConfig{UseCPEs: true})
 	}
-	for _, p := range packages {
-		progressMonitor.PackagesProcessed.Increment()
-		log.WithFields("package", displayPackage(p)).Trace("searching for vulnerability matches")
+	searchPackage := func(p pkg.Package) ([]match.Match, []match.IgnoredMatch) {
+		defer progressMonitor.PackagesProcessed.Increment()
+		return m.searchPackageForMatches(d, p, matcherIndex, defaultMatcher, distroFalsePositivesByLocationPath, progressMonitor)
+	}
 
-		matchAgainst, ok := matcherIndex[p.Type]
-		if !ok {
-			matchAgainst = []matcher.Matcher{defaultMatcher}
+	// results are collected per package and added in the original package order so that the final set of matches
+	// is deterministic regardless of how the work was scheduled.
+	matchesByPackage := make([][]match.Match, len(packages))
+	suppressedByPackage := make([][]match.IgnoredMatch, len(packages))
+
+	workers := m.Parallelism
+	if workers > len(packages) {
+		workers = len(packages)
+	}
+
+	if workers <= 1 {
+		for idx, p := range packages {
+			matchesByPackage[idx], suppressedByPackage[idx] = searchPackage(p)
 		}
-		for _, theMatcher := range matchAgainst {
-			matches, err := theMatcher.Match(m.Store, d, p)
-			if err != nil {
-				log.WithFields("error", err, "package", displayPackage(p)).Warn("matcher failed")
-				continue
-			}
+	} else {
+		indexes := make(chan int)
+		wg := &sync.WaitGroup{}
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for idx := range indexes {
+					matchesByPackage[idx], suppressedByPackage[idx] = searchPackage(packages[idx])
+				}
+			}()
+		}
+		for idx := range packages {
+			indexes <- idx
+		}
+		close(indexes)
+		wg.Wait()
+	}
 
-			matches = filterMatchesUsingDistroFalsePositives(matches, distroFalsePositivesByLocationPath)
+	var suppressed []match.IgnoredMatch
+	for idx, matches := range matchesByPackage {
+		res.Add(matches...)
+		suppressed = append(suppressed, suppressedByPackage[idx]...)
+	}
 
-			// Filter out matches based on records in the database exclusion table and hard-coded rules
-			filtered, dropped := match.ApplyExplicitIgnoreRules(m.Store, match.NewMatches(matches...))
+	return res, suppressed, nil
+}
 
-			additionalMatches := filtered.Sorted()
-			logPackageMatches(p, additionalMatches)
-			logExplicitDroppedPackageMatches(p, dropped)
-			res.Add(additionalMatches...)
+// searchPackageForMatches runs all applicable matchers against a single package, returning the matches that survive
+// false-positive filtering and explicit ignore rules (along with the matches suppressed by distro false-positive data).
+// This is safe to call concurrently.
+func (m *VulnerabilityMatcher) searchPackageForMatches(
+	d *distro.Distro,
+	p pkg.Package,
+	matcherIndex map[syftPkg.Type][]matcher.Matcher,
+	defaultMatcher matcher.Matcher,
+	distroFalsePositivesByLocationPath map[string][]distroFalsePositive,
+	progressMonitor *monitorWriter,
+) ([]match.Match, []match.IgnoredMatch) {
+	log.WithFields("package", displayPackage(p)).Trace("searching for vulnerability matches")
 
-			progressMonitor.MatchesDiscovered.Add(int64(len(additionalMatches)))
+	matchAgainst, ok := matcherIndex[p.Type]
+	if !ok {
+		matchAgainst = []matcher.Matcher{defaultMatcher}
+	}
 
-			// note: there is a difference between "ignore" and "dropped" matches.
-			// ignored: matches that are filtered out due to user-provided ignore rules
-			// dropped: matches that are filtered out due to hard-coded rules
-			updateVulnerabilityList(progressMonitor, additionalMatches, nil, dropped, m.Store)
+	var res []match.Match
+	var suppressed []match.IgnoredMatch
+	for _, theMatcher := range matchAgainst {
+		matches, err := theMatcher.Match(m.Store, d, p)
+		var notEvaluatedErr match.NotEvaluatedError
+		if errors.As(err, &notEvaluatedErr) {
+			log.WithFields("reason", notEvaluatedErr.Reason, "package", displayPackage(p)).Debug("package not evaluated")
+			m.addNotEvaluated(match.NotEvaluated{Package: p, Matcher: theMatcher.Type(), Reason: notEvaluatedErr.Reason})
+			continue
+		}
+		if err != nil {
+			log.WithFields("error", err, "package", displayPackage(p)).Warn("matcher failed")
+			continue
 		}
+
+		matches, distroSuppressed := filterMatchesUsingDistroFalsePositives(matches, distroFalsePositivesByLocationPath)
+		suppressed = append(suppressed, distroSuppressed...)
+
+		// Filter out matches based on records in the database exclusion table and hard-coded rules
+		filtered, dropped := match.ApplyExplicitIgnoreRules(m.Store, match.NewMatches(matches...))
+
+		additionalMatches := filtered.Sorted()
+		logPackageMatches(p, additionalMatches)
+		logExplicitDroppedPackageMatches(p, dropped)
+		res = append(res, additionalMatches...)
+
+		progressMonitor.MatchesDiscovered.Add(int64(len(additionalMatches)))
+
+		// note: there is a difference between "ignore" and "dropped" matches.
+		// ignored: matches that are filtered out due to user-provided ignore rules
+		// dropped: matches that are filtered out due to hard-coded rules
+		updateVulnerabilityList(progressMonitor, additionalMatches, nil, dropped, m.Store)
 	}
 
-	return res, nil
+	return res, suppressed
+}
+
+func (m *VulnerabilityMatcher) addNotEvaluated(n match.NotEvaluated) {
+	m.notEvaluatedLock.Lock()
+	defer m.notEvaluatedLock.Unlock()
+	m.notEvaluated = append(m.notEvaluated, n)
+}
+
+// NotEvaluated returns the packages from the last FindMatches call that a matcher was responsible for but was unable
+// to search for vulnerabilities (these packages should not be considered free of vulnerabilities).
+func (m *VulnerabilityMatcher) NotEvaluated() []match.NotEvaluated {
+	m.notEvaluatedLock.Lock()
+	defer m.notEvaluatedLock.Unlock()
+
+	out := slices.Clone(m.notEvaluated)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Package.Name != out[j].Package.Name {
+			return out[i].Package.Name < out[j].Package.Name
+		}
+		return out[i].Package.Version < out[j].Package.Version
+	})
+	return out
+}
+
+// notAffectedConstraints are the version constraints of distro records that declare a package as not affected by a
+// vulnerability (as opposed to a record that has a fix)
+var notAffectedConstraints = strset.New("< 0 (apk)", "< 0 (deb)", "< 0 (rpm)")
+
+// distroFalsePositive is a vulnerability that a distro has declared as not affecting one of its packages. Any match
+// for the same vulnerability against a package found within the files owned by the distro package is suppressed.
+type distroFalsePositive struct {
+	VulnerabilityID string
+	// Detail describes the distro record that declares the package as not affected
+	Detail match.Detail
+}
+
+// distroFalsePositiveCandidates returns the distro packages that own the location of any other package (only these
+// packages can be used to suppress matches, so there is no need to search the rest)
+func distroFalsePositiveCandidates(packages []pkg.Package) []pkg.Package {
+	locations := strset.New()
+	for _, p := range packages {
+		for _, l := range p.Locations.ToSlice() {
+			locations.Add(l.RealPath)
+		}
+	}
+
+	var candidates []pkg.Package
+	for _, p := range packages {
+		if slices.ContainsFunc(pkg.OwnedFiles(p), func(f string) bool { return locations.Has(f) }) {
+			candidates = append(candidates, p)
+		}
+	}
+	return candidates
 }
 
 func indexFalsePositivesByLocation(
 	d *distro.Distro,
 	packages []pkg.Package,
 	s v5.ProviderStore,
-) (map[string][]string, error) {
-	distroFalsePositivesByLocationPath := make(map[string][]string)
+) (map[string][]distroFalsePositive, error) {
+	distroFalsePositivesByLocationPath := make(map[string][]distroFalsePositive)
 
 	for _, p := range packages {
 		falsePositivesByLocation, err := getDistroFalsePositivesByLocation(s, d, p)
 		if err != nil {
 			return nil, err
 		}
-		for l, vulnIDs := range falsePositivesByLocation {
-			distroFalsePositivesByLocationPath[l] = append(distroFalsePositivesByLocationPath[l], vulnIDs...)
+		for l, fps := range falsePositivesByLocation {
+			distroFalsePositivesByLocationPath[l] = append(distroFalsePositivesByLocationPath[l], fps...)
 		}
 	}
 
 	return distroFalsePositivesByLocationPath, nil
 }
 
-func getDistroFalsePositivesByLocation(s v5.ProviderStore, d *distro.Distro, p pkg.Package) (map[string][]string, error) {
-	result := make(map[string][]string)
+func getDistroFalsePositivesByLocation(s v5.ProviderStore, d *distro.Distro, p pkg.Package) (map[string][]distroFalsePositive, error) {
+	result := make(map[string][]distroFalsePositive)
+
+	files := pkg.OwnedFiles(p)
+	if len(files) == 0 {
+		return result, nil
+	}
 
-	if data, ok := p.Metadata.(pkg.ApkMetadata); ok {
-		entries, err := s.GetByDistro(d, p)
+	for _, searchPkg := range append([]pkg.Package{p}, pkg.UpstreamPackages(p)...) {
+		entries, err := s.GetByDistro(d, searchPkg)
 		if err != nil {
 			return nil, err
 		}
 		for _, entry := range entries {
-			if entry.Constraint.String() == "< 0 (apk)" {
-				for _, f := range data.Files {
-					result[f.Path] = append(result[f.Path], entry.ID)
-				}
+			if !notAffectedConstraints.Has(entry.Constraint.String()) {
+				continue
 			}
-		}
-
-		for _, upstreamPkg := range pkg.UpstreamPackages(p) {
-			entriesForUpstream, err := s.GetByDistro(d, upstreamPkg)
-			if err != nil {
-				return nil, err
+			fp := distroFalsePositive{
+				VulnerabilityID: entry.ID,
+				Detail:          distroFalsePositiveDetail(d, p, searchPkg, entry),
 			}
-			for _, entry := range entriesForUpstream {
-				if entry.Constraint.String() == "< 0 (apk)" {
-					for _, f := range data.Files {
-						result[f.Path] = append(result[f.Path], entry.ID)
-					}
-				}
+			for _, f := range files {
+				result[f] = append(result[f], fp)
 			}
 		}
 	}
@@ -260,35 +486,89 @@ func getDistroFalsePositivesByLocation(s v5.ProviderStore, d *distro.Distro, p p
 	return result, nil
 }
 
-func filterMatchesUsingDistroFalsePositives(ms []match.Match, falsePositivesByLocation map[string][]string) []match.Match {
+// distroFalsePositiveDetail describes the distro record that declares the given package (or the upstream package it
+// was built from) as not affected, in the same shape as the details of distro matches.
+func distroFalsePositiveDetail(d *distro.Distro, p, searchPkg pkg.Package, entry vulnerability.Vulnerability) match.Detail {
+	return match.Detail{
+		Type:    match.DistroNotAffected,
+		Matcher: distroMatcherType(p),
+		SearchedBy: map[string]interface{}{
+			"distro": map[string]string{
+				"type":    d.Type.String(),
+				"version": d.RawVersion,
+			},
+			"package": map[string]string{
+				"name":    searchPkg.Name,
+				"version": searchPkg.Version,
+			},
+			"namespace": entry.Namespace,
+		},
+		Found: map[string]interface{}{
+			"vulnerabilityID":   entry.ID,
+			"versionConstraint": entry.Constraint.String(),
+		},
+		Confidence: 1.0,
+	}
+}
+
+func distroMatcherType(p pkg.Package) match.MatcherType {
+	switch p.Type {
+	case syftPkg.ApkPkg:
+		return match.ApkMatcher
+	case syftPkg.DebPkg:
+		return match.DpkgMatcher
+	case syftPkg.RpmPkg:
+		return match.RpmMatcher
+	default:
+		return match.StockMatcher
+	}
+}
+
+// filterMatchesUsingDistroFalsePositives removes matches that the distro has declared as not affecting the package
+// that owns the location of the matched package. The removed matches are returned as ignored matches, annotated with
+// a detail describing the distro record responsible.
+func filterMatchesUsingDistroFalsePositives(ms []match.Match, falsePositivesByLocation map[string][]distroFalsePositive) ([]match.Match, []match.IgnoredMatch) {
 	var result []match.Match
+	var suppressed []match.IgnoredMatch
 	for _, m := range ms {
-		isFalsePositive := false
+		fp, isFalsePositive := findDistroFalsePositive(m, falsePositivesByLocation)
+		if !isFalsePositive {
+			result = append(result, m)
+			continue
+		}
 
-		for _, l := range m.Package.Locations.ToSlice() {
-			if fpVulnIDs, ok := falsePositivesByLocation[l.RealPath]; ok {
-				if slices.Contains(fpVulnIDs, m.Vulnerability.ID) {
-					isFalsePositive = true
-					break
-				}
+		log.WithFields("vuln", m.Vulnerability.ID, "package", displayPackage(m.Package), "distro-vuln", fp.VulnerabilityID).Trace("dropping false positive using distro security data")
+
+		m.Details = append(slices.Clone(m.Details), fp.Detail)
+		suppressed = append(suppressed, match.IgnoredMatch{
+			Match: m,
+			AppliedIgnoreRules: []match.IgnoreRule{
+				{
+					Vulnerability: m.Vulnerability.ID,
+					Reason:        fmt.Sprintf("the distro has declared %s as not affecting the package that owns this location", fp.VulnerabilityID),
+				},
+			},
+		})
+	}
 
-				for _, relatedVulnerability := range m.Vulnerability.RelatedVulnerabilities {
-					if slices.Contains(fpVulnIDs, relatedVulnerability.ID) {
-						isFalsePositive = true
-						break
-					}
-				}
+	return result, suppressed
+}
+
+func findDistroFalsePositive(m match.Match, falsePositivesByLocation map[string][]distroFalsePositive) (distroFalsePositive, bool) {
+	for _, l := range m.Package.Locations.ToSlice() {
+		for _, fp := range falsePositivesByLocation[l.RealPath] {
+			if fp.VulnerabilityID == m.Vulnerability.ID {
+				return fp, true
 			}
-		}
 
-		if !isFalsePositive {
-			result = append(result, m)
-		} else {
-			log.WithFields("vuln", m.Vulnerability.ID, "package", displayPackage(m.Package)).Trace("dropping false positive using distro security data")
+			for _, relatedVulnerability := range m.Vulnerability.RelatedVulnerabilities {
+				if fp.VulnerabilityID == relatedVulnerability.ID {
+					return fp, true
+				}
+			}
 		}
 	}
-
-	return result
+	return distroFalsePositive{}, false
 }
 
 func (m *VulnerabilityMatcher) findVEXMatches(context pkg.Context, remainingMatches *match.Matches, ignoredMatches []match.IgnoredMatch, progressMonitor *monitorWriter) (*match.Matches, []match.IgnoredMatch, error) {
@@ -312,13 +592,41 @@ func (m *VulnerabilityMatcher) findVEXMatches(context pkg.Context, remainingMatc
 	return matchesAfterVex, ignoredMatchesAfterVex, nil
 }
 
-func (m *VulnerabilityMatcher) applyIgnoreRules(matches match.Matches) (match.Matches, []match.IgnoredMatch) {
+// now returns the current time according to the clock of the matcher
+func (m *VulnerabilityMatcher) now() time.Time {
+	if m.Clock != nil {
+		return m.Clock()
+	}
+	return time.Now()
+}
+
+// warnExpiredIgnoreRules notifies the user about ignore rules that have expired (and are no longer suppressing any matches)
+func warnExpiredIgnoreRules(rules []match.IgnoreRule, at time.Time) {
+	for _, rule := range match.ExpiredIgnoreRules(rules, at) {
+		msg := fmt.Sprintf("ignore rule has expired (expires=%q", rule.Expires)
+		if rule.Vulnerability != "" {
+			msg += fmt.Sprintf(" vulnerability=%q", rule.Vulnerability)
+		}
+		if rule.Owner != "" {
+			msg += fmt.Sprintf(" owner=%q", rule.Owner)
+		}
+		msg += "), matching vulnerabilities are no longer suppressed"
+		bus.Notify(msg)
+	}
+}
+
+func (m *VulnerabilityMatcher) applyIgnoreRules(matches match.Matches, at time.Time) (match.Matches, []match.IgnoredMatch) {
 	var ignoredMatches []match.IgnoredMatch
 	if len(m.IgnoreRules) == 0 {
 		return matches, ignoredMatches
 	}
 
-	matches, ignoredMatches = match.ApplyIgnoreRules(matches, m.IgnoreRules)
+	var metadataProvider vulnerability.MetadataProvider
+	if m.Store.VulnerabilityMetadataProvider != nil {
+		metadataProvider = m.Store
+	}
+
+	matches, ignoredMatches = match.ApplyIgnoreRulesWithMetadata(matches, m.IgnoreRules, metadataProvider, at)
 
 	if count := len(ignoredMatches); count > 0 {
 		log.Infof("ignoring %d matches due to user-provided ignore rules", count)
@@ -326,61 +634,187 @@ func (m *VulnerabilityMatcher) applyIgnoreRules(matches match.Matches) (match.Ma
 	return matches, ignoredMatches
 }
 
-func (m *VulnerabilityMatcher) normalizeByCVE(match match.Match) match.Match {
-	if isCVE(match.Vulnerability.ID) {
-		return match
+// applyIgnoreRulesToAliases ignores the matches where an ignore rule applies to any of the alias candidates of the
+// matched vulnerability, as if the match had been made against that alias.
+func (m *VulnerabilityMatcher) applyIgnoreRulesToAliases(matches match.Matches, aliases vulnerabilityAliases, at time.Time) (match.Matches, []match.IgnoredMatch) {
+	var ignoredMatches []match.IgnoredMatch
+	if len(m.IgnoreRules) == 0 {
+		return matches, ignoredMatches
+	}
+
+	var metadataProvider vulnerability.MetadataProvider
+	if m.Store.VulnerabilityMetadataProvider != nil {
+		metadataProvider = m.Store
 	}
 
-	var effectiveCVERecordRefs []vulnerability.Reference
-	for _, ref := range match.Vulnerability.RelatedVulnerabilities {
-		if isCVE(ref.ID) {
-			effectiveCVERecordRefs = append(effectiveCVERecordRefs, ref)
-			break
+	remainingMatches := match.NewMatches()
+	for original := range matches.Enumerate() {
+		var appliedRules []match.IgnoreRule
+		for _, ref := range aliases.candidates(original.Vulnerability) {
+			alias := original
+			alias.Vulnerability.ID = ref.ID
+			alias.Vulnerability.Namespace = ref.Namespace
+
+			_, ignored := match.ApplyIgnoreRulesWithMetadata(match.NewMatches(alias), m.IgnoreRules, metadataProvider, at)
+			for _, i := range ignored {
+				for _, rule := range i.AppliedIgnoreRules {
+					if !slices.Contains(appliedRules, rule) {
+						appliedRules = append(appliedRules, rule)
+					}
+				}
+			}
 		}
+
+		if len(appliedRules) == 0 {
+			remainingMatches.Add(original)
+			continue
+		}
+		ignoredMatches = append(ignoredMatches, match.IgnoredMatch{
+			Match:              original,
+			AppliedIgnoreRules: appliedRules,
+		})
 	}
 
-	switch len(effectiveCVERecordRefs) {
-	case 0:
-		log.WithFields(
-			"vuln", match.Vulnerability.ID,
-			"package", displayPackage(match.Package),
-		).Trace("unable to find CVE record for vulnerability, skipping normalization")
-		return match
-	case 1:
-		break
-	default:
-		log.WithFields(
-			"refs", fmt.Sprintf("%+v", effectiveCVERecordRefs),
-			"vuln", match.Vulnerability.ID,
-			"package", displayPackage(match.Package),
-		).Trace("found multiple CVE records for vulnerability, skipping normalization")
-		return match
+	if count := len(ignoredMatches); count > 0 {
+		log.Infof("ignoring %d matches due to user-provided ignore rules on vulnerability aliases", count)
+	}
+	return remainingMatches, ignoredMatches
+}
+
+// normalizeBy returns the ordered ID prefixes (e.g. "ghsa", "cve") that matches are normalized by, which is empty
+// when matches should be reported with the original vulnerability IDs.
+func (m *VulnerabilityMatcher) normalizeBy() []string {
+	if len(m.NormalizeBy) > 0 {
+		return m.NormalizeBy
+	}
+	if m.NormalizeByCVE {
+		return []string{"cve"}
 	}
+	return nil
+}
 
-	ref := effectiveCVERecordRefs[0]
+// vulnerabilityAliases indexes the vulnerability references that are known to be aliases of each vulnerability ID,
+// from the related vulnerabilities of all matches. This allows for normalizing by an ID that is only known from the
+// other direction (e.g. a GHSA record that relates to a CVE, while the CVE record does not relate to the GHSA).
+type vulnerabilityAliases map[string][]vulnerability.Reference
+
+func newVulnerabilityAliases(matches match.Matches, ignoredMatches []match.IgnoredMatch) vulnerabilityAliases {
+	aliases := make(vulnerabilityAliases)
+	add := func(m match.Match) {
+		for _, related := range m.Vulnerability.RelatedVulnerabilities {
+			if !slices.Contains(aliases[related.ID], m.Vulnerability.Reference) {
+				aliases[related.ID] = append(aliases[related.ID], m.Vulnerability.Reference)
+			}
+		}
+	}
+	for m := range matches.Enumerate() {
+		add(m)
+	}
+	for _, m := range ignoredMatches {
+		add(m.Match)
+	}
 
-	upstreamMetadata, err := m.Store.GetMetadata(ref.ID, ref.Namespace)
-	if err != nil {
-		log.WithFields("id", ref.ID, "namespace", ref.Namespace, "error", err).Warn("unable to fetch effective CVE metadata")
-		return match
+	// for stable output
+	for id := range aliases {
+		sort.Slice(aliases[id], func(i, j int) bool {
+			a, b := aliases[id][i], aliases[id][j]
+			if a.ID == b.ID {
+				return a.Namespace < b.Namespace
+			}
+			return a.ID < b.ID
+		})
 	}
+	return aliases
+}
 
-	if upstreamMetadata == nil {
-		return match
+// candidates returns the references the given vulnerability may be normalized to, the related vulnerabilities of the
+// record first.
+func (a vulnerabilityAliases) candidates(v vulnerability.Vulnerability) []vulnerability.Reference {
+	refs := slices.Clone(v.RelatedVulnerabilities)
+	for _, ref := range a[v.ID] {
+		if !slices.Contains(refs, ref) {
+			refs = append(refs, ref)
+		}
 	}
+	return refs
+}
+
+// normalize re-keys the match onto the most preferred vulnerability ID that is an alias of the matched vulnerability
+// (e.g. the CVE for a GHSA), keeping the original vulnerability as a related vulnerability. When there are several
+// aliases with the most preferred prefix (e.g. a GHSA that relates to more than one CVE) there is no single record to
+// re-key onto, so the match is left as is.
+func (m *VulnerabilityMatcher) normalize(match match.Match, preferences []string, aliases vulnerabilityAliases) match.Match {
+	candidates := aliases.candidates(match.Vulnerability)
+
+	for _, prefix := range preferences {
+		if hasIDPrefix(match.Vulnerability.ID, prefix) {
+			return match
+		}
+
+		var preferred []*vulnerability.Metadata
+		for _, ref := range candidates {
+			if !hasIDPrefix(ref.ID, prefix) {
+				continue
+			}
 
-	originalRef := vulnerability.Reference{
-		ID:        match.Vulnerability.ID,
-		Namespace: match.Vulnerability.Namespace,
+			upstreamMetadata, err := m.Store.GetMetadata(ref.ID, ref.Namespace)
+			if err != nil {
+				log.WithFields("id", ref.ID, "namespace", ref.Namespace, "error", err).Warn("unable to fetch effective vulnerability metadata")
+				continue
+			}
+
+			if upstreamMetadata == nil {
+				continue
+			}
+
+			if !slices.ContainsFunc(preferred, func(md *vulnerability.Metadata) bool { return md.ID == upstreamMetadata.ID }) {
+				preferred = append(preferred, upstreamMetadata)
+			}
+		}
+
+		switch len(preferred) {
+		case 0:
+			continue
+		case 1:
+			originalRef := vulnerability.Reference{
+				ID:        match.Vulnerability.ID,
+				Namespace: match.Vulnerability.Namespace,
+			}
+
+			match.Vulnerability.ID = preferred[0].ID
+			match.Vulnerability.Namespace = preferred[0].Namespace
+			match.Vulnerability.RelatedVulnerabilities = []vulnerability.Reference{originalRef}
+
+			return match
+		default:
+			var ids []string
+			for _, md := range preferred {
+				ids = append(ids, md.ID)
+			}
+			log.WithFields(
+				"vuln", match.Vulnerability.ID,
+				"aliases", strings.Join(ids, ","),
+				"package", displayPackage(match.Package),
+			).Debug("vulnerability has several preferred aliases, skipping normalization")
+
+			return match
+		}
 	}
 
-	match.Vulnerability.ID = upstreamMetadata.ID
-	match.Vulnerability.Namespace = upstreamMetadata.Namespace
-	match.Vulnerability.RelatedVulnerabilities = []vulnerability.Reference{originalRef}
+	log.WithFields(
+		"vuln", match.Vulnerability.ID,
+		"preferences", strings.Join(preferences, ","),
+		"package", displayPackage(match.Package),
+	).Trace("unable to find a preferred record for vulnerability, skipping normalization")
 
 	return match
 }
 
+// hasIDPrefix indicates if the vulnerability ID is within the given namespace of IDs (e.g. "GHSA-xxxx-xxxx-xxxx" for "ghsa").
+func hasIDPrefix(id, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(id), strings.ToLower(prefix)+"-")
+}
+
 func displayPackage(p pkg.Package) string {
 	if p.PURL != "" {
 		return p.PURL
@@ -428,10 +862,6 @@ func newMatcherIndex(matchers []matcher.Matcher) (map[syftPkg.Type][]matcher.Mat
 	return matcherIndex, defaultMatcher
 }
 
-func isCVE(id string) bool {
-	return strings.HasPrefix(strings.ToLower(id), "cve-")
-}
-
 func HasSeverityAtOrAbove(store v5.VulnerabilityMetadataProvider, severity vulnerability.Severity, matches match.Matches) bool {
 	if severity == vulnerability.UnknownSeverity {
 		return false
@@ -449,6 +879,36 @@ func HasSeverityAtOrAbove(store v5.VulnerabilityMetadataProvider, severity vulne
 	return false
 }
 
+func HasEPSSPercentileAtOrAbove(store v5.VulnerabilityMetadataProvider, percentile float64, matches match.Matches) bool {
+	for m := range matches.Enumerate() {
+		metadata, err := store.GetMetadata(m.Vulnerability.ID, m.Vulnerability.Namespace)
+		if err != nil || metadata == nil {
+			continue
+		}
+
+		for _, e := range metadata.EPSS {
+			if e.Percentile >= percentile {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func HasKnownExploited(store v5.VulnerabilityMetadataProvider, matches match.Matches) bool {
+	for m := range matches.Enumerate() {
+		metadata, err := store.GetMetadata(m.Vulnerability.ID, m.Vulnerability.Namespace)
+		if err != nil || metadata == nil {
+			continue
+		}
+
+		if len(metadata.KnownExploited) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func logListSummary(vl *monitorWriter) {
 	log.Infof("found %d vulnerability matches across %d packages", vl.MatchesDiscovered.Current(), vl.PackagesProcessed.Current())
 	log.Debugf("  ├── fixed: %d", vl.Fixed.Current())
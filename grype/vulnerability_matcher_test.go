@@ -1,15 +1,19 @@
 package grype
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	gopenvex "github.com/openvex/go-vex/pkg/vex"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wagoodman/go-partybus"
 
+	"github.com/anchore/grype/grype/baseline"
 	v5 "github.com/anchore/grype/grype/db/v5"
 	"github.com/anchore/grype/grype/db/v5/matcher"
 	"github.com/anchore/grype/grype/db/v5/matcher/ruby"
@@ -21,8 +25,10 @@ import (
 	"github.com/anchore/grype/grype/match"
 	"github.com/anchore/grype/grype/pkg"
 	"github.com/anchore/grype/grype/pkg/qualifier"
+	"github.com/anchore/grype/grype/presenter/models"
 	"github.com/anchore/grype/grype/version"
 	"github.com/anchore/grype/grype/vex"
+	vexOpenVex "github.com/anchore/grype/grype/vex/openvex"
 	"github.com/anchore/grype/grype/vulnerability"
 	"github.com/anchore/grype/internal/bus"
 	"github.com/anchore/syft/syft/cpe"
@@ -291,6 +297,128 @@ func Test_HasSeverityAtOrAbove(t *testing.T) {
 	}
 }
 
+type stubMetadataProvider map[string]vulnerability.Metadata
+
+func (p stubMetadataProvider) GetMetadata(id, namespace string) (*vulnerability.Metadata, error) {
+	m := p[id]
+	m.ID = id
+	m.Namespace = namespace
+	return &m, nil
+}
+
+func (p stubMetadataProvider) VulnerabilityMetadata(ref vulnerability.Reference) (*vulnerability.Metadata, error) {
+	return p.GetMetadata(ref.ID, ref.Namespace)
+}
+
+func newStubMatches(ids ...string) match.Matches {
+	matches := match.NewMatches()
+	for _, id := range ids {
+		matches.Add(match.Match{
+			Vulnerability: vulnerability.Vulnerability{
+				Reference: vulnerability.Reference{
+					ID:        id,
+					Namespace: "debian:distro:debian:8",
+				},
+			},
+			Package: pkg.Package{
+				ID:      pkg.ID(uuid.NewString()),
+				Name:    "the-package",
+				Version: "v0.1",
+				Type:    syftPkg.RpmPkg,
+			},
+		})
+	}
+	return matches
+}
+
+func Test_HasEPSSPercentileAtOrAbove(t *testing.T) {
+	matches := newStubMatches("CVE-2014-fake-1")
+
+	provider := stubMetadataProvider{
+		"CVE-2014-fake-1": {
+			EPSS: []vulnerability.EPSS{
+				{CVE: "CVE-2014-fake-1", EPSS: 0.3, Percentile: 0.9},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		provider       stubMetadataProvider
+		percentile     float64
+		expectedResult bool
+	}{
+		{
+			name:           "no EPSS data",
+			provider:       stubMetadataProvider{},
+			percentile:     0.1,
+			expectedResult: false,
+		},
+		{
+			name:           "below threshold",
+			provider:       provider,
+			percentile:     0.95,
+			expectedResult: false,
+		},
+		{
+			name:           "at threshold",
+			provider:       provider,
+			percentile:     0.9,
+			expectedResult: true,
+		},
+		{
+			name:           "above threshold",
+			provider:       provider,
+			percentile:     0.5,
+			expectedResult: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, HasEPSSPercentileAtOrAbove(tt.provider, tt.percentile, matches))
+		})
+	}
+}
+
+func Test_HasKnownExploited(t *testing.T) {
+	provider := stubMetadataProvider{
+		"CVE-2014-fake-1": {
+			KnownExploited: []vulnerability.KnownExploited{
+				{CVE: "CVE-2014-fake-1", Product: "the-package"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		matches        match.Matches
+		expectedResult bool
+	}{
+		{
+			name:           "no matches",
+			matches:        match.NewMatches(),
+			expectedResult: false,
+		},
+		{
+			name:           "no KEV matches",
+			matches:        newStubMatches("CVE-2014-fake-2"),
+			expectedResult: false,
+		},
+		{
+			name:           "KEV match",
+			matches:        newStubMatches("CVE-2014-fake-2", "CVE-2014-fake-1"),
+			expectedResult: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, HasKnownExploited(provider, tt.matches))
+		})
+	}
+}
+
 func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 	mkStr := newMockStore(defaultStubFn)
 	vp, err := v5.NewVulnerabilityProvider(mkStr)
@@ -333,6 +461,7 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 		IgnoreRules    []match.IgnoreRule
 		FailSeverity   *vulnerability.Severity
 		NormalizeByCVE bool
+		NormalizeBy    []string
 		VexProcessor   *vex.Processor
 	}
 	type args struct {
@@ -556,6 +685,21 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 								Matcher:    "dpkg-matcher",
 								Confidence: 1,
 							},
+							{
+								Type: match.ExactDirectMatch,
+								SearchedBy: &vexOpenVex.SearchedBy{
+									Vulnerability: "CVE-2014-fake-1",
+									Product:       "pkg:oci/debian@sha256%3A124c7d2707904eea7431fffe91522a01e5a861a624ee31d03372cc1d138a3126?repository_url=index.docker.io%2Flibrary",
+									Subcomponents: []string{},
+								},
+								Found: vexOpenVex.Match{
+									Statement: gopenvex.Statement{
+										Vulnerability: gopenvex.Vulnerability{Name: "CVE-2014-fake-1"},
+										Status:        gopenvex.StatusFixed,
+									},
+								},
+								Matcher: match.OpenVexMatcher,
+							},
 						},
 					},
 				},
@@ -744,7 +888,7 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 			wantErr:            nil,
 		},
 		{
-			name: "normalize by cve -- ignore GHSA",
+			name: "normalize by ghsa, then cve",
 			fields: fields{
 				Store: str,
 				Matchers: matcher.NewDefaultMatchers(
@@ -754,12 +898,7 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 						},
 					},
 				),
-				IgnoreRules: []match.IgnoreRule{
-					{
-						Vulnerability: "GHSA-2014-fake-3",
-					},
-				},
-				NormalizeByCVE: true, // IMPORTANT!
+				NormalizeBy: []string{"ghsa", "cve"}, // IMPORTANT!
 			},
 			args: args{
 				pkgs: []pkg.Package{
@@ -773,17 +912,37 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 						PackageName: "activerecord",
 						Constraint:  version.MustGetConstraint("< 3.7.6", version.UnknownFormat),
 						Reference: vulnerability.Reference{
-							ID:        "CVE-2014-fake-3",
-							Namespace: "nvd:cpe",
+							ID:        "GHSA-2014-fake-3",
+							Namespace: "github:language:ruby",
 						},
 						CPEs: []cpe.CPE{
 							mustCPE("cpe:2.3:*:activerecord:activerecord:*:*:*:*:*:rails:*:*"),
 						},
 						PackageQualifiers: []qualifier.Qualifier{},
 						Advisories:        []vulnerability.Advisory{},
+						RelatedVulnerabilities: []vulnerability.Reference{
+							{
+								ID:        "CVE-2014-fake-3",
+								Namespace: "nvd:cpe",
+							},
+						},
 					},
 					Package: activerecordPkg,
 					Details: match.Details{
+						{
+							Type: match.ExactDirectMatch,
+							SearchedBy: map[string]any{
+								"language":  "ruby",
+								"namespace": "github:language:ruby",
+								"package":   map[string]string{"name": "activerecord", "version": "3.7.5"},
+							},
+							Found: map[string]any{
+								"versionConstraint": "< 3.7.6 (unknown)",
+								"vulnerabilityID":   "GHSA-2014-fake-3",
+							},
+							Matcher:    "ruby-gem-matcher",
+							Confidence: 1,
+						},
 						{
 							Type: match.CPEMatch,
 							SearchedBy: search.CPEParameters{
@@ -809,7 +968,101 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 					},
 				},
 			),
-			wantErr: nil,
+			wantIgnoredMatches: nil,
+			wantErr:            nil,
+		},
+		{
+			name: "normalize by ghsa, then cve -- ignore related CVE",
+			fields: fields{
+				Store:    str,
+				Matchers: matcher.NewDefaultMatchers(matcher.Config{}), // the related CVE is not CPE matched
+				IgnoreRules: []match.IgnoreRule{
+					{
+						Vulnerability: "CVE-2014-fake-3",
+					},
+				},
+				NormalizeBy: []string{"ghsa", "cve"}, // IMPORTANT!
+			},
+			args: args{
+				pkgs: []pkg.Package{
+					activerecordPkg,
+				},
+				context: pkg.Context{},
+			},
+			wantMatches: match.NewMatches(),
+			wantErr:     nil,
+			wantIgnoredMatches: []match.IgnoredMatch{
+				{
+					Match: match.Match{
+						Vulnerability: vulnerability.Vulnerability{
+							PackageName: "activerecord",
+							Constraint:  version.MustGetConstraint("< 3.7.6", version.UnknownFormat),
+							Reference: vulnerability.Reference{
+								ID:        "GHSA-2014-fake-3",
+								Namespace: "github:language:ruby",
+							},
+							CPEs:              []cpe.CPE{},
+							PackageQualifiers: []qualifier.Qualifier{},
+							Advisories:        []vulnerability.Advisory{},
+							RelatedVulnerabilities: []vulnerability.Reference{
+								{
+									ID:        "CVE-2014-fake-3",
+									Namespace: "nvd:cpe",
+								},
+							},
+						},
+						Package: activerecordPkg,
+						Details: match.Details{
+							{
+								Type: match.ExactDirectMatch,
+								SearchedBy: map[string]any{
+									"language":  "ruby",
+									"namespace": "github:language:ruby",
+									"package":   map[string]string{"name": "activerecord", "version": "3.7.5"},
+								},
+								Found: map[string]any{
+									"versionConstraint": "< 3.7.6 (unknown)",
+									"vulnerabilityID":   "GHSA-2014-fake-3",
+								},
+								Matcher:    "ruby-gem-matcher",
+								Confidence: 1,
+							},
+						},
+					},
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Vulnerability: "CVE-2014-fake-3",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "normalize by cve -- ignore GHSA",
+			fields: fields{
+				Store: str,
+				Matchers: matcher.NewDefaultMatchers(
+					matcher.Config{
+						Ruby: ruby.MatcherConfig{
+							UseCPEs: true,
+						},
+					},
+				),
+				IgnoreRules: []match.IgnoreRule{
+					{
+						Vulnerability: "GHSA-2014-fake-3",
+					},
+				},
+				NormalizeByCVE: true, // IMPORTANT!
+			},
+			args: args{
+				pkgs: []pkg.Package{
+					activerecordPkg,
+				},
+				context: pkg.Context{},
+			},
+			wantMatches: match.NewMatches(),
+			wantErr:     nil,
 			wantIgnoredMatches: []match.IgnoredMatch{
 				{
 					Match: match.Match{
@@ -854,6 +1107,53 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 						},
 					},
 				},
+				{
+					Match: match.Match{
+						Vulnerability: vulnerability.Vulnerability{
+							PackageName: "activerecord",
+							Constraint:  version.MustGetConstraint("< 3.7.6", version.UnknownFormat),
+							Reference: vulnerability.Reference{
+								ID:        "CVE-2014-fake-3",
+								Namespace: "nvd:cpe",
+							},
+							CPEs: []cpe.CPE{
+								mustCPE("cpe:2.3:*:activerecord:activerecord:*:*:*:*:*:rails:*:*"),
+							},
+							PackageQualifiers: []qualifier.Qualifier{},
+							Advisories:        []vulnerability.Advisory{},
+						},
+						Package: activerecordPkg,
+						Details: match.Details{
+							{
+								Type: match.CPEMatch,
+								SearchedBy: search.CPEParameters{
+									Namespace: "nvd:cpe",
+									CPEs: []string{
+										"cpe:2.3:*:activerecord:activerecord:3.7.5:*:*:*:*:rails:*:*",
+									},
+									Package: search.CPEPackageParameter{
+										Name:    "activerecord",
+										Version: "3.7.5",
+									},
+								},
+								Found: search.CPEResult{
+									VulnerabilityID:   "CVE-2014-fake-3",
+									VersionConstraint: "< 3.7.6 (unknown)",
+									CPEs: []string{
+										"cpe:2.3:*:activerecord:activerecord:*:*:*:*:*:rails:*:*",
+									},
+								},
+								Matcher:    "ruby-gem-matcher",
+								Confidence: 0.9,
+							},
+						},
+					},
+					AppliedIgnoreRules: []match.IgnoreRule{
+						{
+							Vulnerability: "GHSA-2014-fake-3",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -1094,6 +1394,7 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 				IgnoreRules:    tt.fields.IgnoreRules,
 				FailSeverity:   tt.fields.FailSeverity,
 				NormalizeByCVE: tt.fields.NormalizeByCVE,
+				NormalizeBy:    tt.fields.NormalizeBy,
 				VexProcessor:   tt.fields.VexProcessor,
 			}
 
@@ -1115,6 +1416,10 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 				cmpopts.IgnoreFields(vulnerability.Vulnerability{}, "Constraint"),
 				cmpopts.IgnoreFields(pkg.Package{}, "Locations"),
 				cmpopts.IgnoreUnexported(match.IgnoredMatch{}),
+				// only the identity of the VEX statement recorded with a match is of interest here
+				cmp.Comparer(func(a, b vexOpenVex.Match) bool {
+					return a.Statement.Vulnerability.Name == b.Statement.Vulnerability.Name && a.Statement.Status == b.Statement.Status
+				}),
 			}
 
 			if d := cmp.Diff(tt.wantMatches.Sorted(), actualMatches.Sorted(), opts...); d != "" {
@@ -1131,6 +1436,78 @@ func TestVulnerabilityMatcher_FindMatches(t *testing.T) {
 	}
 }
 
+func TestVulnerabilityMatcher_FindMatches_Parallelism(t *testing.T) {
+	str := createMockStore(t, defaultStubFn)
+
+	var pkgs []pkg.Package
+	for i := 0; i < 50; i++ {
+		// distinct locations keep the sorted ordering stable across otherwise identical packages
+		location := file.NewLocationSet(file.NewLocation(fmt.Sprintf("/pkg/%02d", i)))
+		pkgs = append(pkgs,
+			pkg.Package{
+				ID:        pkg.ID(uuid.NewString()),
+				Name:      "neutron",
+				Version:   "2013.1.1-1",
+				Type:      syftPkg.DebPkg,
+				Locations: location,
+			},
+			pkg.Package{
+				ID:        pkg.ID(uuid.NewString()),
+				Name:      "activerecord",
+				Version:   "3.7.5",
+				Type:      syftPkg.GemPkg,
+				Language:  syftPkg.Ruby,
+				Locations: location,
+			},
+		)
+	}
+
+	pkgContext := pkg.Context{
+		Distro: &linux.Release{
+			ID:        "debian",
+			VersionID: "8",
+		},
+	}
+
+	findMatches := func(parallelism int) (match.Matches, monitor.Matching) {
+		t.Helper()
+		listener := &busListener{}
+		bus.Set(listener)
+		defer bus.Set(nil)
+
+		m := DefaultVulnerabilityMatcher(str).WithParallelism(parallelism)
+		matches, _, err := m.FindMatches(pkgs, pkgContext)
+		require.NoError(t, err)
+		return *matches, listener.matching
+	}
+
+	sequentialMatches, sequentialMonitor := findMatches(1)
+	require.Equal(t, 100, sequentialMatches.Count())
+
+	for _, parallelism := range []int{0, 2, 8, 200} {
+		t.Run(fmt.Sprintf("parallelism=%d", parallelism), func(t *testing.T) {
+			parallelMatches, parallelMonitor := findMatches(parallelism)
+
+			opts := []cmp.Option{
+				cmpopts.IgnoreUnexported(match.Match{}),
+				cmpopts.IgnoreFields(vulnerability.Vulnerability{}, "Constraint"),
+				cmpopts.IgnoreFields(pkg.Package{}, "Locations"),
+			}
+			if d := cmp.Diff(sequentialMatches.Sorted(), parallelMatches.Sorted(), opts...); d != "" {
+				t.Errorf("matches differ from sequential search (-want +got):\n%s", d)
+			}
+
+			assert.Equal(t, int64(len(pkgs)), parallelMonitor.PackagesProcessed.Current())
+			assert.Equal(t, sequentialMonitor.MatchesDiscovered.Current(), parallelMonitor.MatchesDiscovered.Current())
+			assert.Equal(t, sequentialMonitor.Fixed.Current(), parallelMonitor.Fixed.Current())
+			assert.Equal(t, sequentialMonitor.Dropped.Current(), parallelMonitor.Dropped.Current())
+			for sev, p := range sequentialMonitor.BySeverity {
+				assert.Equal(t, p.Current(), parallelMonitor.BySeverity[sev].Current(), "severity %s", sev)
+			}
+		})
+	}
+}
+
 func Test_indexFalsePositivesByLocation(t *testing.T) {
 	cases := []struct {
 		name           string
@@ -1255,6 +1632,75 @@ func Test_indexFalsePositivesByLocation(t *testing.T) {
 			expectedResult: map[string][]string{},
 			errAssertion:   assert.NoError,
 		},
+		{
+			name: "false positive in debian source package adds index entry",
+			d:    mustDistro(t, distro.Debian, "12"),
+			pkgs: []pkg.Package{
+				{
+					Name:  "python3-urllib3",
+					Type:  syftPkg.DebPkg,
+					Files: []string{"/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO"},
+					Upstreams: []pkg.UpstreamPackage{
+						{
+							Name: "python-urllib3",
+						},
+					},
+				},
+			},
+			stubFunc: func(d *mockStore) {
+				d.vulnerabilities["debian:distro:debian:12"] = map[string][]v5.Vulnerability{
+					"python-urllib3": {
+						{
+							ID:                "CVE-2023-fake-1",
+							PackageName:       "python-urllib3",
+							Namespace:         "debian:distro:debian:12",
+							VersionConstraint: "< 0",
+							VersionFormat:     "dpkg",
+						},
+						{
+							ID:                "CVE-2023-fake-2",
+							PackageName:       "python-urllib3",
+							Namespace:         "debian:distro:debian:12",
+							VersionConstraint: "< 1.26.12-1+deb12u1",
+							VersionFormat:     "dpkg",
+						},
+					},
+				}
+			},
+			expectedResult: map[string][]string{
+				"/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO": {"CVE-2023-fake-1"},
+			},
+			errAssertion: assert.NoError,
+		},
+		{
+			name: "false positive in rhel package adds index entry",
+			d:    mustDistro(t, distro.RedHat, "9"),
+			pkgs: []pkg.Package{
+				{
+					Name:  "nodejs-foo",
+					Type:  syftPkg.RpmPkg,
+					Files: []string{"/usr/lib/node_modules/foo/package.json", "/usr/share/doc/foo"},
+				},
+			},
+			stubFunc: func(d *mockStore) {
+				d.vulnerabilities["redhat:distro:redhat:9"] = map[string][]v5.Vulnerability{
+					"nodejs-foo": {
+						{
+							ID:                "CVE-2023-fake-3",
+							PackageName:       "nodejs-foo",
+							Namespace:         "redhat:distro:redhat:9",
+							VersionConstraint: "< 0",
+							VersionFormat:     "rpm",
+						},
+					},
+				}
+			},
+			expectedResult: map[string][]string{
+				"/usr/lib/node_modules/foo/package.json": {"CVE-2023-fake-3"},
+				"/usr/share/doc/foo":                     {"CVE-2023-fake-3"},
+			},
+			errAssertion: assert.NoError,
+		},
 		{
 			name: "no files listed for a wolfi package",
 			d:    distro.Distro{Type: distro.Wolfi},
@@ -1287,11 +1733,97 @@ func Test_indexFalsePositivesByLocation(t *testing.T) {
 			s := createMockStore(t, tt.stubFunc)
 			actualResult, err := indexFalsePositivesByLocation(&tt.d, tt.pkgs, s)
 			tt.errAssertion(t, err)
-			assert.Equal(t, tt.expectedResult, actualResult)
+			assert.Equal(t, tt.expectedResult, falsePositiveIDsByLocation(actualResult))
 		})
 	}
 }
 
+func falsePositiveIDsByLocation(index map[string][]distroFalsePositive) map[string][]string {
+	ids := make(map[string][]string)
+	for l, fps := range index {
+		for _, fp := range fps {
+			ids[l] = append(ids[l], fp.VulnerabilityID)
+		}
+	}
+	return ids
+}
+
+func mustDistro(t *testing.T, ty distro.Type, version string) distro.Distro {
+	t.Helper()
+	d, err := distro.New(ty, version)
+	require.NoError(t, err)
+	return *d
+}
+
+func Test_distroFalsePositiveDetail(t *testing.T) {
+	d := mustDistro(t, distro.Debian, "12")
+	s := createMockStore(t, func(d *mockStore) {
+		d.vulnerabilities["debian:distro:debian:12"] = map[string][]v5.Vulnerability{
+			"python-urllib3": {
+				{
+					ID:                "CVE-2023-fake-1",
+					PackageName:       "python-urllib3",
+					Namespace:         "debian:distro:debian:12",
+					VersionConstraint: "< 0",
+					VersionFormat:     "dpkg",
+				},
+			},
+		}
+	})
+
+	p := pkg.Package{
+		Name:      "python3-urllib3",
+		Version:   "1.26.12-1",
+		Type:      syftPkg.DebPkg,
+		Files:     []string{"/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO"},
+		Upstreams: []pkg.UpstreamPackage{{Name: "python-urllib3", Version: "1.26.12-1"}},
+	}
+
+	index, err := indexFalsePositivesByLocation(&d, []pkg.Package{p}, s)
+	require.NoError(t, err)
+
+	fps := index["/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO"]
+	require.Len(t, fps, 1)
+	assert.Equal(t, match.Detail{
+		Type:    match.DistroNotAffected,
+		Matcher: match.DpkgMatcher,
+		SearchedBy: map[string]interface{}{
+			"distro": map[string]string{
+				"type":    "debian",
+				"version": "12",
+			},
+			"package": map[string]string{
+				"name":    "python-urllib3",
+				"version": "1.26.12-1",
+			},
+			"namespace": "debian:distro:debian:12",
+		},
+		Found: map[string]interface{}{
+			"vulnerabilityID":   "CVE-2023-fake-1",
+			"versionConstraint": "< 0 (deb)",
+		},
+		Confidence: 1.0,
+	}, fps[0].Detail)
+}
+
+func Test_distroFalsePositiveCandidates(t *testing.T) {
+	owner := pkg.Package{
+		Name:  "python3-urllib3",
+		Files: []string{"/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO"},
+	}
+	unrelatedOwner := pkg.Package{
+		Name:  "libc6",
+		Files: []string{"/lib/x86_64-linux-gnu/libc.so.6"},
+	}
+	languagePkg := pkg.Package{
+		Name:      "urllib3",
+		Locations: file.NewLocationSet(file.NewLocation("/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO")),
+	}
+
+	assert.Equal(t, []pkg.Package{owner}, distroFalsePositiveCandidates([]pkg.Package{owner, unrelatedOwner, languagePkg}))
+	assert.Empty(t, distroFalsePositiveCandidates([]pkg.Package{unrelatedOwner, languagePkg}))
+}
+
 func createMockStore(t *testing.T, fn mockStoreStubFn) v5.ProviderStore {
 	t.Helper()
 
@@ -1411,8 +1943,157 @@ func Test_filterMatchesUsingDistroFalsePositives(t *testing.T) {
 
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
-			actual := filterMatchesUsingDistroFalsePositives(tt.inputMatches, tt.fpIndex)
+			fpIndex := make(map[string][]distroFalsePositive)
+			for l, ids := range tt.fpIndex {
+				fpIndex[l] = []distroFalsePositive{}
+				for _, id := range ids {
+					fpIndex[l] = append(fpIndex[l], distroFalsePositive{VulnerabilityID: id})
+				}
+			}
+			actual, suppressed := filterMatchesUsingDistroFalsePositives(tt.inputMatches, fpIndex)
 			assert.Equal(t, tt.expected, actual)
+			assert.Len(t, suppressed, len(tt.inputMatches)-len(tt.expected))
+		})
+	}
+}
+
+func Test_filterMatchesUsingDistroFalsePositives_Suppressed(t *testing.T) {
+	detail := match.Detail{
+		Type:    match.DistroNotAffected,
+		Matcher: match.DpkgMatcher,
+		Found: map[string]interface{}{
+			"vulnerabilityID":   "CVE-2023-fake-1",
+			"versionConstraint": "< 0 (deb)",
+		},
+	}
+	languageDetail := match.Detail{Type: match.ExactDirectMatch, Matcher: match.PythonMatcher}
+	m := match.Match{
+		Package: pkg.Package{
+			Name:      "urllib3",
+			Locations: file.NewLocationSet(file.NewLocation("/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO")),
+		},
+		// the distro record is for the CVE, which is related to the GHSA that was matched
+		Vulnerability: vulnerability.Vulnerability{
+			Reference:              vulnerability.Reference{ID: "GHSA-fake-1"},
+			RelatedVulnerabilities: []vulnerability.Reference{{ID: "CVE-2023-fake-1"}},
+		},
+		Details: match.Details{languageDetail},
+	}
+
+	remaining, suppressed := filterMatchesUsingDistroFalsePositives([]match.Match{m}, map[string][]distroFalsePositive{
+		"/usr/lib/python3/dist-packages/urllib3-1.26.12.egg-info/PKG-INFO": {{VulnerabilityID: "CVE-2023-fake-1", Detail: detail}},
+	})
+
+	assert.Empty(t, remaining)
+	require.Len(t, suppressed, 1)
+	assert.Equal(t, match.Details{languageDetail, detail}, suppressed[0].Details)
+	require.Len(t, suppressed[0].AppliedIgnoreRules, 1)
+	assert.Equal(t, "GHSA-fake-1", suppressed[0].AppliedIgnoreRules[0].Vulnerability)
+	assert.Contains(t, suppressed[0].AppliedIgnoreRules[0].Reason, "CVE-2023-fake-1")
+	// the original match is not modified
+	assert.Equal(t, match.Details{languageDetail}, m.Details)
+}
+
+func TestVulnerabilityMatcher_flagStatuses(t *testing.T) {
+	newMatch := func(id string, status vulnerability.Status) match.Match {
+		return match.Match{
+			Package: pkg.Package{ID: pkg.ID(id), Name: "a-pkg"},
+			Vulnerability: vulnerability.Vulnerability{
+				Reference: vulnerability.Reference{ID: id},
+				Status:    status,
+			},
+		}
+	}
+
+	vm := (&VulnerabilityMatcher{}).WithFlaggedStatuses(vulnerability.StatusDisputed)
+	matches, ignored := vm.flagStatuses(
+		match.NewMatches(newMatch("CVE-2024-0001", vulnerability.StatusDisputed), newMatch("CVE-2024-0002", vulnerability.StatusAnalyzing)),
+		[]match.IgnoredMatch{{Match: newMatch("CVE-2024-0003", vulnerability.StatusDisputed)}},
+	)
+
+	flagged := map[string]vulnerability.Status{}
+	for _, m := range matches.Sorted() {
+		flagged[m.Vulnerability.ID] = m.FlaggedStatus
+	}
+	assert.Equal(t, map[string]vulnerability.Status{
+		"CVE-2024-0001": vulnerability.StatusDisputed,
+		"CVE-2024-0002": "",
+	}, flagged)
+	require.Len(t, ignored, 1)
+	assert.Equal(t, vulnerability.StatusDisputed, ignored[0].FlaggedStatus)
+}
+
+func TestVulnerabilityMatcher_normalize(t *testing.T) {
+	str := createMockStore(t, func(d *mockStore) {
+		defaultStubFn(d)
+		d.metadata["CVE-2014-fake-4"] = map[string]*v5.VulnerabilityMetadata{
+			"nvd:cpe": {
+				ID:        "CVE-2014-fake-4",
+				Namespace: "nvd:cpe",
+			},
+		}
+	})
+
+	ghsa := vulnerability.Reference{ID: "GHSA-2014-fake-3", Namespace: "github:language:ruby"}
+	cve3 := vulnerability.Reference{ID: "CVE-2014-fake-3", Namespace: "nvd:cpe"}
+	cve4 := vulnerability.Reference{ID: "CVE-2014-fake-4", Namespace: "nvd:cpe"}
+
+	newMatch := func(ref vulnerability.Reference, related ...vulnerability.Reference) match.Match {
+		return match.Match{
+			Package: pkg.Package{ID: "a-pkg", Name: "activerecord"},
+			Vulnerability: vulnerability.Vulnerability{
+				Reference:              ref,
+				RelatedVulnerabilities: related,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		match   match.Match
+		aliases vulnerabilityAliases
+		want    vulnerability.Reference
+		related []vulnerability.Reference
+	}{
+		{
+			name:    "single alias with the preferred prefix",
+			match:   newMatch(ghsa, cve3),
+			want:    cve3,
+			related: []vulnerability.Reference{ghsa},
+		},
+		{
+			name:    "alias from the reverse index",
+			match:   newMatch(ghsa),
+			aliases: vulnerabilityAliases{ghsa.ID: {cve3}},
+			want:    cve3,
+			related: []vulnerability.Reference{ghsa},
+		},
+		{
+			name:    "several aliases with the preferred prefix are ambiguous",
+			match:   newMatch(ghsa, cve3, cve4),
+			want:    ghsa,
+			related: []vulnerability.Reference{cve3, cve4},
+		},
+		{
+			name:    "several aliases across related records and the reverse index are ambiguous",
+			match:   newMatch(ghsa, cve3),
+			aliases: vulnerabilityAliases{ghsa.ID: {cve4}},
+			want:    ghsa,
+			related: []vulnerability.Reference{cve3},
+		},
+		{
+			name:    "already preferred",
+			match:   newMatch(cve3, ghsa),
+			want:    cve3,
+			related: []vulnerability.Reference{ghsa},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &VulnerabilityMatcher{Store: str}
+			got := m.normalize(tt.match, []string{"cve"}, tt.aliases)
+			assert.Equal(t, tt.want, got.Vulnerability.Reference)
+			assert.Equal(t, tt.related, got.Vulnerability.RelatedVulnerabilities)
 		})
 	}
 }
@@ -1430,3 +2111,128 @@ func (b *busListener) Publish(e partybus.Event) {
 }
 
 var _ partybus.Publisher = (*busListener)(nil)
+
+func TestVulnerabilityMatcher_FindMatches_Baseline(t *testing.T) {
+	str := createMockStore(t, defaultStubFn)
+
+	neutron := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "neutron",
+		Version: "2013.1.1-1",
+		Type:    syftPkg.DebPkg,
+	}
+	pkgContext := pkg.Context{
+		Distro: &linux.Release{
+			ID:        "debian",
+			VersionID: "8",
+		},
+	}
+	failSeverity := vulnerability.NegligibleSeverity
+
+	// without a baseline all matches are reported and are considered by the fail-on check
+	allMatches, _, err := DefaultVulnerabilityMatcher(str).
+		FailAtOrAboveSeverity(&failSeverity).
+		FindMatches([]pkg.Package{neutron}, pkgContext)
+	require.ErrorIs(t, err, grypeerr.ErrAboveSeverityThreshold)
+	require.NotZero(t, allMatches.Count())
+
+	var doc models.Document
+	for _, m := range allMatches.Sorted() {
+		var model models.Match
+		model.Vulnerability.ID = m.Vulnerability.ID
+		model.Vulnerability.Namespace = m.Vulnerability.Namespace
+		model.Vulnerability.Fix.Versions = m.Vulnerability.Fix.Versions
+		model.Artifact.ID = string(m.Package.ID)
+		doc.Matches = append(doc.Matches, model)
+	}
+
+	// with a baseline of the same results there is nothing new to report (or fail on)
+	remaining, ignored, err := DefaultVulnerabilityMatcher(str).
+		FailAtOrAboveSeverity(&failSeverity).
+		WithBaseline(baseline.New(doc)).
+		FindMatches([]pkg.Package{neutron}, pkgContext)
+	require.NoError(t, err)
+	assert.Zero(t, remaining.Count())
+	require.Len(t, ignored, allMatches.Count())
+	for _, m := range ignored {
+		assert.Equal(t, baseline.Namespace, m.AppliedIgnoreRules[0].Namespace)
+	}
+}
+
+func TestVulnerabilityMatcher_FindMatches_NotEvaluated(t *testing.T) {
+	str := createMockStore(t, defaultStubFn)
+
+	conanPkg := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "somelib",
+		Version: "1.0.0",
+		Type:    syftPkg.ConanPkg,
+		PURL:    "pkg:conan/somelib@1.0.0",
+	}
+	neutron := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "neutron",
+		Version: "2013.1.1-1",
+		Type:    syftPkg.DebPkg,
+	}
+	pkgContext := pkg.Context{
+		Distro: &linux.Release{
+			ID:        "debian",
+			VersionID: "8",
+		},
+	}
+
+	m := DefaultVulnerabilityMatcher(str)
+	matches, _, err := m.FindMatches([]pkg.Package{neutron, conanPkg}, pkgContext)
+	require.NoError(t, err)
+	assert.NotZero(t, matches.Count())
+
+	notEvaluated := m.NotEvaluated()
+	require.Len(t, notEvaluated, 1)
+	assert.Equal(t, conanPkg.ID, notEvaluated[0].Package.ID)
+	assert.Equal(t, match.ConanMatcher, notEvaluated[0].Matcher)
+	assert.NotEmpty(t, notEvaluated[0].Reason)
+
+	// results are reset with each search
+	_, _, err = m.FindMatches([]pkg.Package{neutron}, pkgContext)
+	require.NoError(t, err)
+	assert.Empty(t, m.NotEvaluated())
+}
+
+func TestVulnerabilityMatcher_FindMatches_ExpiredIgnoreRules(t *testing.T) {
+	str := createMockStore(t, defaultStubFn)
+
+	neutron := pkg.Package{
+		ID:      pkg.ID(uuid.NewString()),
+		Name:    "neutron",
+		Version: "2013.1.1-1",
+		Type:    syftPkg.DebPkg,
+	}
+	pkgContext := pkg.Context{
+		Distro: &linux.Release{
+			ID:        "debian",
+			VersionID: "8",
+		},
+	}
+	rule := match.IgnoreRule{Package: match.IgnoreRulePackage{Name: "neutron"}, Expires: "2025-06-30"}
+
+	findMatches := func(at time.Time) (*match.Matches, []match.IgnoredMatch) {
+		m := DefaultVulnerabilityMatcher(str).WithIgnoreRules([]match.IgnoreRule{rule})
+		m.Clock = func() time.Time { return at }
+		remaining, ignored, err := m.FindMatches([]pkg.Package{neutron}, pkgContext)
+		require.NoError(t, err)
+		return remaining, ignored
+	}
+
+	// the rule applies until the end of the day it expires
+	remaining, ignored := findMatches(time.Date(2025, 6, 30, 23, 0, 0, 0, time.UTC))
+	assert.Zero(t, remaining.Count())
+	assert.NotEmpty(t, ignored)
+
+	remaining, ignored = findMatches(time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC))
+	assert.Empty(t, ignored)
+	require.NotZero(t, remaining.Count())
+	for _, m := range remaining.Sorted() {
+		assert.Equal(t, []match.IgnoreRule{rule}, m.ExpiredIgnoreRules)
+	}
+}
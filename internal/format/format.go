@@ -12,6 +12,7 @@ const (
 	CycloneDXJSON   Format = "cyclonedx-json"
 	CycloneDXXML    Format = "cyclonedx-xml"
 	SarifFormat     Format = "sarif"
+	OpenVEXFormat   Format = "openvex"
 	TemplateFormat  Format = "template"
 
 	// DEPRECATED <-- TODO: remove in v1.0
@@ -39,6 +40,8 @@ func Parse(userInput string) Format {
 		return SarifFormat
 	case strings.ToLower(TemplateFormat.String()):
 		return TemplateFormat
+	case strings.ToLower(OpenVEXFormat.String()):
+		return OpenVEXFormat
 	case strings.ToLower(CycloneDXFormat.String()):
 		return CycloneDXFormat
 	case strings.ToLower(CycloneDXJSON.String()):
@@ -62,6 +65,7 @@ var AvailableFormats = []Format{
 	CycloneDXJSON,
 	SarifFormat,
 	TemplateFormat,
+	OpenVEXFormat,
 }
 
 // DeprecatedFormats TODO: remove in v1.0
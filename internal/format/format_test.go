@@ -23,6 +23,10 @@ func TestParse(t *testing.T) {
 			"jSOn",
 			JSONFormat,
 		},
+		{
+			"openvex",
+			OpenVEXFormat,
+		},
 		{
 			"booboodepoopoo",
 			UnknownFormat,
@@ -6,6 +6,7 @@ import (
 	"github.com/anchore/grype/grype/presenter/cyclonedx"
 	"github.com/anchore/grype/grype/presenter/json"
 	"github.com/anchore/grype/grype/presenter/models"
+	"github.com/anchore/grype/grype/presenter/openvex"
 	"github.com/anchore/grype/grype/presenter/sarif"
 	"github.com/anchore/grype/grype/presenter/table"
 	"github.com/anchore/grype/grype/presenter/template"
@@ -38,6 +39,8 @@ func GetPresenter(format Format, c PresentationConfig, pb models.PresenterConfig
 		return sarif.NewPresenter(pb)
 	case TemplateFormat:
 		return template.NewPresenter(pb, c.TemplateFilePath)
+	case OpenVEXFormat:
+		return openvex.NewPresenter(pb)
 	// DEPRECATED TODO: remove in v1.0
 	case EmbeddedVEXJSON:
 		log.Warn("embedded-cyclonedx-vex-json format is deprecated and will be removed in v1.0")
@@ -60,6 +60,22 @@ func newMockDbStore() *mockStore {
 						CPEs:              []string{"cpe:2.3:a:lib_vnc_project-(server):libvncserver:*:*:*:*:*:*:*:*"},
 					},
 				},
+				"zlib": []v5.Vulnerability{
+					{
+						ID:                "CVE-conan-zlib",
+						VersionConstraint: "< 1.2.12",
+						VersionFormat:     "unknown",
+						CPEs:              []string{"cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*"},
+					},
+				},
+				"openssl": []v5.Vulnerability{
+					{
+						ID:                "CVE-nix-openssl",
+						VersionConstraint: "< 3.0.8",
+						VersionFormat:     "unknown",
+						CPEs:              []string{"cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*"},
+					},
+				},
 				"my-package": []v5.Vulnerability{
 					{
 						ID:                "CVE-bogus-my-package-1",
@@ -253,6 +269,69 @@ func newMockDbStore() *mockStore {
 					},
 				},
 			},
+			"archlinux:distro:archlinux:rolling": {
+				"openldap": []v5.Vulnerability{
+					{
+						ID:                "CVE-alpm-openldap",
+						VersionConstraint: "< 2.6.5-1",
+						VersionFormat:     "pacman",
+					},
+				},
+			},
+			"github:language:php": {
+				"laravel/framework": []v5.Vulnerability{
+					{
+						ID:                "CVE-php-laravel",
+						VersionConstraint: "< 8.22.1",
+						VersionFormat:     "composer",
+					},
+				},
+			},
+			"github:language:dart": {
+				"http": []v5.Vulnerability{
+					{
+						ID:                "CVE-dart-http",
+						VersionConstraint: "< 0.13.3",
+						VersionFormat:     "pub",
+					},
+				},
+			},
+			"github:language:erlang": {
+				"plug": []v5.Vulnerability{
+					{
+						ID:                "CVE-hex-plug",
+						VersionConstraint: "< 1.10.1",
+						VersionFormat:     "hex",
+					},
+				},
+			},
+			"github:language:swift": {
+				"github.com/vapor/vapor": []v5.Vulnerability{
+					{
+						ID:                "CVE-swift-vapor",
+						VersionConstraint: "< 4.1.0",
+						VersionFormat:     "unknown",
+					},
+				},
+			},
+			"github:language:github-action": {
+				"tj-actions/changed-files": []v5.Vulnerability{
+					{
+						ID:                "CVE-github-action-changed-files",
+						VersionConstraint: "< 35.7.7",
+						VersionFormat:     "unknown",
+					},
+				},
+			},
+			"kernel.org:language:linux-kernel": {
+				"linux": []v5.Vulnerability{
+					{
+						ID:                "CVE-kernel-linux",
+						VersionConstraint: ">= 5.10, < 5.10.150",
+						VersionFormat:     "kernel",
+					},
+				},
+			},
 		},
 	}
 }
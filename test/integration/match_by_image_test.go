@@ -2,6 +2,7 @@ package integration
 
 import (
 	"context"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -17,6 +18,7 @@ import (
 	"github.com/anchore/grype/grype/db/v5/matcher/golang"
 	"github.com/anchore/grype/grype/db/v5/matcher/java"
 	"github.com/anchore/grype/grype/db/v5/matcher/javascript"
+	"github.com/anchore/grype/grype/db/v5/matcher/nix"
 	"github.com/anchore/grype/grype/db/v5/matcher/python"
 	"github.com/anchore/grype/grype/db/v5/matcher/ruby"
 	"github.com/anchore/grype/grype/db/v5/matcher/rust"
@@ -632,6 +634,361 @@ func addRustMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Coll
 	}
 }
 
+func addPhpComposerMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/app/composer.lock")
+	if len(packages) != 1 {
+		t.Logf("PHP Composer Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (php-composer-lock-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	theVuln := theStore.backend["github:language:php"][thePkg.Name][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactDirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"language":  "php",
+					"namespace": "github:language:php",
+					"package": map[string]string{
+						"name":    thePkg.Name,
+						"version": thePkg.Version,
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.PhpComposerMatcher,
+			},
+		},
+	})
+}
+
+func addDartPubMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/app/pubspec.lock")
+	if len(packages) != 1 {
+		t.Logf("Dart Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (dart-pubspec-lock-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	theVuln := theStore.backend["github:language:dart"][thePkg.Name][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactDirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"language":  "dart",
+					"namespace": "github:language:dart",
+					"package": map[string]string{
+						"name":    thePkg.Name,
+						"version": thePkg.Version,
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.DartPubMatcher,
+			},
+		},
+	})
+}
+
+func addHexMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/app/mix.lock")
+	if len(packages) != 1 {
+		t.Logf("Hex Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (elixir-mix-lock-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	// advisories for elixir packages are within the erlang namespace
+	theVuln := theStore.backend["github:language:erlang"][thePkg.Name][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactDirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"language":  "erlang",
+					"namespace": "github:language:erlang",
+					"package": map[string]string{
+						"name":    thePkg.Name,
+						"version": thePkg.Version,
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.HexMatcher,
+			},
+		},
+	})
+}
+
+func addSwiftMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/app/Package.resolved")
+	if len(packages) != 1 {
+		t.Logf("Swift Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (swift-package-manager-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	// advisories for swift packages are keyed by the repository of the package
+	theVuln := theStore.backend["github:language:swift"]["github.com/vapor/vapor"][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactDirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"language":  "swift",
+					"namespace": "github:language:swift",
+					"package": map[string]string{
+						"name":    "github.com/vapor/vapor",
+						"version": thePkg.Version,
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.SwiftMatcher,
+			},
+		},
+	})
+}
+
+func addConanMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/app/conan.lock")
+	if len(packages) != 1 {
+		t.Logf("Conan Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (conan-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	theVuln := theStore.backend["nvd:cpe"][thePkg.Name][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+	vulnObj.CPEs = []cpe.CPE{
+		cpe.Must("cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*", ""),
+	}
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.CPEMatch,
+				Confidence: 0.9,
+				SearchedBy: search.CPEParameters{
+					Namespace: "nvd:cpe",
+					// the CPE is for the upstream project of the package rather than generated from the package name
+					CPEs: []string{
+						"cpe:2.3:a:zlib:zlib:1.2.11:*:*:*:*:*:*:*",
+					},
+					Package: search.CPEPackageParameter{Name: "zlib", Version: "1.2.11"},
+				},
+				Found: search.CPEResult{
+					VulnerabilityID:   "CVE-conan-zlib",
+					VersionConstraint: "< 1.2.12 (unknown)",
+					CPEs: []string{
+						"cpe:2.3:a:zlib:zlib:*:*:*:*:*:*:*:*",
+					},
+				},
+				Matcher: match.ConanMatcher,
+			},
+		},
+	})
+}
+
+func addAlpmMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/var/lib/pacman/local/libldap-2.6.4-1/desc")
+	if len(packages) != 1 {
+		t.Logf("Alpm Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (alpm-db-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	// the split package is matched by the base package it is built from
+	theVuln := theStore.backend["archlinux:distro:archlinux:rolling"]["openldap"][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactIndirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"distro": map[string]string{
+						"type":    "archlinux",
+						"version": "",
+					},
+					"namespace": "archlinux:distro:archlinux:rolling",
+					"package": map[string]string{
+						"name":    "openldap",
+						"version": thePkg.Version,
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.AlpmMatcher,
+			},
+		},
+	})
+}
+
+func addGithubActionsMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/.github/workflows/ci.yaml")
+	if len(packages) != 1 {
+		t.Logf("GitHub Actions Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (github-actions-usage-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	theVuln := theStore.backend["github:language:github-action"][thePkg.Name][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactDirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"language":  "github-action",
+					"namespace": "github:language:github-action",
+					"package": map[string]string{
+						"name": thePkg.Name,
+						// the version is the release the tag refers to
+						"version": "35.0.0",
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.GithubActionsMatcher,
+			},
+		},
+	})
+}
+
+func addKernelMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	packages := catalog.PackagesByPath("/boot/vmlinuz-5.10.121")
+	if len(packages) != 1 {
+		t.Logf("Kernel Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (linux-kernel-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	theVuln := theStore.backend["kernel.org:language:linux-kernel"]["linux"][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.ExactDirectMatch,
+				Confidence: 1.0,
+				SearchedBy: map[string]any{
+					"language":  "linux-kernel",
+					"namespace": "kernel.org:language:linux-kernel",
+					"package": map[string]string{
+						"name":    "linux",
+						"version": thePkg.Version,
+					},
+				},
+				Found: map[string]any{
+					"versionConstraint": vulnObj.Constraint.String(),
+					"vulnerabilityID":   vulnObj.ID,
+				},
+				Matcher: match.KernelMatcher,
+			},
+		},
+	})
+}
+
+func addNixMatches(t *testing.T, theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore, theResult *match.Matches) {
+	storePath := "/nix/store/s66mzxpvicwk07gjbjfw9izjfa797vsw-openssl-3.0.7"
+	packages := catalog.PackagesByPath(storePath)
+	if len(packages) != 1 {
+		t.Logf("Nix Packages: %+v", packages)
+		t.Fatalf("problem with upstream syft cataloger (nix-store-cataloger)")
+	}
+
+	thePkg := pkg.New(packages[0])
+	theVuln := theStore.backend["nvd:cpe"][thePkg.Name][0]
+	vulnObj, err := v5.NewVulnerability(theVuln)
+	require.NoError(t, err)
+	vulnObj.CPEs = []cpe.CPE{
+		cpe.Must("cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*", ""),
+	}
+
+	theResult.Add(match.Match{
+		Vulnerability: *vulnObj,
+		Package:       thePkg,
+		Details: []match.Detail{
+			{
+				Type:       match.CPEMatch,
+				Confidence: 0.9,
+				SearchedBy: nix.CPEParameters{
+					CPEParameters: search.CPEParameters{
+						Namespace: "nvd:cpe",
+						CPEs: []string{
+							"cpe:2.3:a:openssl:openssl:3.0.7:*:*:*:*:*:*:*",
+						},
+						Package: search.CPEPackageParameter{Name: "openssl", Version: "3.0.7"},
+					},
+					StorePath: storePath,
+				},
+				Found: search.CPEResult{
+					VulnerabilityID:   "CVE-nix-openssl",
+					VersionConstraint: "< 3.0.8 (unknown)",
+					CPEs: []string{
+						"cpe:2.3:a:openssl:openssl:*:*:*:*:*:*:*:*",
+					},
+				},
+				Matcher: match.NixMatcher,
+			},
+		},
+	})
+}
+
 func TestMatchByImage(t *testing.T) {
 	observedMatchers := stringutil.NewStringSet()
 	definedMatchers := stringutil.NewStringSet()
@@ -640,7 +997,9 @@ func TestMatchByImage(t *testing.T) {
 	}
 
 	tests := []struct {
-		name       string
+		name string
+		// dir indicates that the fixture is scanned as a directory rather than built into an image
+		dir        bool
 		expectedFn func(source.Source, *syftPkg.Collection, *mockStore) match.Matches
 	}{
 		{
@@ -707,17 +1066,37 @@ func TestMatchByImage(t *testing.T) {
 				return expectedMatches
 			},
 		},
+		{
+			name: "dir-match-coverage",
+			dir:  true,
+			expectedFn: func(theSource source.Source, catalog *syftPkg.Collection, theStore *mockStore) match.Matches {
+				expectedMatches := match.NewMatches()
+				addPhpComposerMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addDartPubMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addHexMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addSwiftMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addConanMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addAlpmMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addGithubActionsMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addKernelMatches(t, theSource, catalog, theStore, &expectedMatches)
+				addNixMatches(t, theSource, catalog, theStore, &expectedMatches)
+				return expectedMatches
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			theStore := newMockDbStore()
 
-			imagetest.GetFixtureImage(t, "docker-archive", test.name)
-			tarPath := imagetest.GetFixtureImageTarPath(t, test.name)
+			userInput, sourceType := filepath.Join("test-fixtures", test.name), "dir"
+			if !test.dir {
+				imagetest.GetFixtureImage(t, "docker-archive", test.name)
+				userInput, sourceType = imagetest.GetFixtureImageTarPath(t, test.name), "docker-archive"
+			}
 
 			// this is purely done to help setup mocks
-			theSource, err := syft.GetSource(context.Background(), tarPath, syft.DefaultGetSourceConfig().WithSources("docker-archive"))
+			theSource, err := syft.GetSource(context.Background(), userInput, syft.DefaultGetSourceConfig().WithSources(sourceType))
 			require.NoError(t, err)
 			t.Cleanup(func() {
 				require.NoError(t, theSource.Close())
@@ -838,6 +1217,8 @@ func TestMatchByImage(t *testing.T) {
 	definedMatchers.Remove(string(match.StockMatcher))
 	definedMatchers.Remove(string(match.MsrcMatcher))
 	definedMatchers.Remove(string(match.PortageMatcher)) // TODO: add this back in when #744 is complete
+	definedMatchers.Remove(string(match.CycloneDXVexMatcher))
+	definedMatchers.Remove(string(match.CSAFVexMatcher))
 
 	if len(observedMatchers) != len(definedMatchers) {
 		t.Errorf("matcher coverage incomplete (matchers=%d, coverage=%d)", len(definedMatchers), len(observedMatchers))